@@ -34,6 +34,8 @@ type metaConfig struct {
 	kevFile            *os.File
 	listSrcReader      io.Reader
 	listSrcName        string
+	validateSrcReader  io.Reader
+	validateSrcName    string
 	listFormat         string
 	listAll            bool
 	configOutputWriter io.Writer
@@ -64,14 +64,35 @@ var configConvertCmd = &cobra.Command{
 	},
 }
 
+var configExceptionsCmd = &cobra.Command{
+	Use:   "exceptions",
+	Short: "list risk acceptances that expire within a number of days",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		configFilename, _ := cmd.Flags().GetString("file")
+		RuntimeConfig.gatecheckConfig = &gatecheck.Config{}
+		return gatecheck.NewConfigDecoder(configFilename).Decode(RuntimeConfig.gatecheckConfig)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		days, _ := cmd.Flags().GetInt("days")
+		if days < 0 {
+			return errors.New("invalid --days, must be zero or more")
+		}
+
+		return gatecheck.ListExceptions(cmd.OutOrStdout(), RuntimeConfig.gatecheckConfig, days)
+	},
+}
+
 func newConfigCommand() *cobra.Command {
 	configConvertCmd.Flags().StringP("file", "f", "gatecheck.yaml", "gatecheck validation config file")
 	configConvertCmd.Flags().StringP("output", "o", "yaml", "Format to convert into formats=[json yaml yml toml]")
 	configInitCmd.Flags().StringP("output", "o", "yaml", "Format to convert into formats=[json yaml yml toml]")
+	configExceptionsCmd.Flags().StringP("file", "f", "gatecheck.yaml", "gatecheck validation config file")
+	configExceptionsCmd.Flags().IntP("days", "d", 30, "list acceptances that expire within this many days, expired acceptances are always listed")
 
 	_ = configConvertCmd.MarkFlagFilename("file", "json", "yaml", "yml", "toml")
 	_ = configInitCmd.MarkFlagFilename("file", "json", "yaml", "yml", "toml")
+	_ = configExceptionsCmd.MarkFlagFilename("file", "json", "yaml", "yml", "toml")
 
-	configCmd.AddCommand(configInitCmd, configConvertCmd)
+	configCmd.AddCommand(configInitCmd, configConvertCmd, configExceptionsCmd)
 	return configCmd
 }
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -12,23 +13,17 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var supportedTypes = []string{"grype", "semgrep", "gitleaks", "syft", "cyclonedx", "bundle", "gatecheck"}
-
 var listCmd = &cobra.Command{
 	Use:     "list",
 	Short:   "print a table of the findings in a report or files in a gatecheck bundle",
 	Aliases: []string{"ls", "print"},
 	PreRunE: func(cmd *cobra.Command, args []string) error {
-		inputType, _ := cmd.Flags().GetString("input-type")
-		if inputType == "" && len(args) == 0 {
-			return errors.New("Need either input-type for STDIN or filename as argument")
-		}
-
 		var err error = nil
 
+		// The report type is detected from content, input-type is an optional override
 		if len(args) == 0 {
 			RuntimeConfig.listSrcReader = cmd.InOrStdin()
-			RuntimeConfig.listSrcName = fmt.Sprintf("stdin:%s", inputType)
+			RuntimeConfig.listSrcName = "stdin"
 		} else {
 			RuntimeConfig.listSrcReader, err = os.Open(args[0])
 			RuntimeConfig.listSrcName = args[0]
@@ -44,6 +39,20 @@ var listCmd = &cobra.Command{
 			RuntimeConfig.listFormat = "markdown"
 		}
 
+		formatFlag, _ := cmd.Flags().GetString("format")
+		switch formatFlag {
+		case "":
+		case "ascii", "markdown", "sarif":
+			RuntimeConfig.listFormat = formatFlag
+		default:
+			return fmt.Errorf("unsupported list format '%s', supported: [ascii|markdown|sarif]", formatFlag)
+		}
+
+		RuntimeConfig.gatecheckConfig, err = listGatecheckConfig()
+		if err != nil {
+			return err
+		}
+
 		if epss, _ := cmd.Flags().GetBool("epss"); !epss {
 			return nil
 		}
@@ -65,14 +74,17 @@ var listCmd = &cobra.Command{
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		epss, _ := cmd.Flags().GetBool("epss")
+		inputType, _ := cmd.Flags().GetString("input-type")
 
 		dst := cmd.OutOrStdout()
 		src := RuntimeConfig.listSrcReader
 		srcName := RuntimeConfig.listSrcName
 		displayOpt := gatecheck.WithDisplayFormat(RuntimeConfig.listFormat)
+		inputTypeOpt := gatecheck.WithListInputType(inputType)
+		configOpt := gatecheck.WithListConfig(RuntimeConfig.gatecheckConfig)
 
 		if !epss {
-			return gatecheck.List(dst, src, srcName, displayOpt)
+			return gatecheck.List(dst, src, srcName, displayOpt, inputTypeOpt, configOpt)
 		}
 
 		epssURL := RuntimeConfig.EPSSURL.Value().(string)
@@ -84,7 +96,7 @@ var listCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		return gatecheck.List(dst, src, srcName, displayOpt, epssOpt)
+		return gatecheck.List(dst, src, srcName, displayOpt, inputTypeOpt, configOpt, epssOpt)
 	},
 }
 
@@ -96,21 +108,31 @@ var listAllCmd = &cobra.Command{
 		markdown, _ := cmd.Flags().GetBool("markdown")
 		slog.Debug("run list all", "epss", fmt.Sprintf("%v", epss), "markdown", fmt.Sprintf("%v", markdown))
 
+		config, err := listGatecheckConfig()
+		if err != nil {
+			return err
+		}
+
 		for _, filename := range args {
-			supportedFunc := func(s string) bool {
-				return strings.Contains(filename, s)
-			}
 			cmd.Printf("%s\n", filename)
-			if !slices.ContainsFunc(supportedTypes, supportedFunc) {
-				slog.Warn("file not supported, skip", "filename", filename)
-				continue
-			}
 
 			if _, err := os.Stat(filename); errors.Is(err, os.ErrNotExist) {
 				slog.Error("file not found, skip", "filename", filename)
 				continue
 			}
 
+			content, err := os.ReadFile(filename)
+			if err != nil {
+				slog.Error("cannot open file, skip", "filename", filename, "error", err)
+				continue
+			}
+
+			reportType := gatecheck.DetectReportType(content)
+			if reportType == "" {
+				slog.Warn("file not supported, skip", "filename", filename)
+				continue
+			}
+
 			epssURL := RuntimeConfig.EPSSURL.Value().(string)
 			epssFile := RuntimeConfig.epssFile
 
@@ -119,9 +141,9 @@ var listAllCmd = &cobra.Command{
 			if markdown {
 				displayOpt = gatecheck.WithDisplayFormat("markdown")
 			}
-			opts = append(opts, displayOpt)
+			opts = append(opts, displayOpt, gatecheck.WithListInputType(reportType), gatecheck.WithListConfig(config))
 
-			if epss && slices.ContainsFunc([]string{"grype", "cyclonedx"}, supportedFunc) {
+			if epss && slices.Contains([]string{gatecheck.ReportTypeGrype, gatecheck.ReportTypeCyclonedx, gatecheck.ReportTypeTrivy, gatecheck.ReportTypeOsv}, reportType) {
 				epssOpt, err := gatecheck.WithEPSS(epssFile, epssURL)
 				if err != nil {
 					slog.Error("epss fetch failure, skip", "filename", filename, "error", err)
@@ -131,13 +153,7 @@ var listAllCmd = &cobra.Command{
 			}
 
 			dst := cmd.OutOrStdout()
-			src, err := os.Open(filename)
-			if err != nil {
-				slog.Error("cannot open file, skip", "filename", filename, "error", err)
-				continue
-			}
-
-			err = gatecheck.List(dst, src, filename, opts...)
+			err = gatecheck.List(dst, bytes.NewReader(content), filename, opts...)
 			if err != nil {
 				slog.Error("cannot list report, skip", "filename", filename, "error", err)
 				continue
@@ -148,16 +164,37 @@ var listAllCmd = &cobra.Command{
 	},
 }
 
+// listGatecheckConfig the config is optional when listing, nil if no config file is set
+func listGatecheckConfig() (*gatecheck.Config, error) {
+	configFilename := RuntimeConfig.ConfigFilename.Value().(string)
+	if configFilename == "" {
+		return nil, nil
+	}
+	config := gatecheck.NewDefaultConfig()
+	if err := gatecheck.NewConfigDecoder(configFilename).Decode(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+var inputTypeUsage = fmt.Sprintf(
+	"override content detection of the report type [%s]",
+	strings.Join(gatecheck.SupportedReportTypes, "|"),
+)
+
 func newListAllCommand() *cobra.Command {
 	listAllCmd.Flags().Bool("markdown", false, "print as a markdown table")
 	listAllCmd.Flags().Bool("epss", false, "List with EPSS data")
+	RuntimeConfig.ConfigFilename.SetupCobra(listAllCmd)
 	return listAllCmd
 }
 
 func newListCommand() *cobra.Command {
-	listCmd.Flags().StringP("input-type", "i", "", "the input filetype if using STDIN [grype|semgrep|gitleaks|syft|bundle]")
+	listCmd.Flags().StringP("input-type", "i", "", inputTypeUsage)
 	listCmd.Flags().Bool("markdown", false, "print as a markdown table")
+	listCmd.Flags().String("format", "", "output format [ascii|markdown|sarif], overrides --markdown")
 	listCmd.Flags().Bool("epss", false, "List with EPSS data")
+	RuntimeConfig.ConfigFilename.SetupCobra(listCmd)
 	RuntimeConfig.EPSSURL.SetupCobra(listCmd)
 	RuntimeConfig.EPSSFilename.SetupCobra(listCmd)
 	return listCmd
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 
@@ -12,7 +13,7 @@ import (
 var validateCmd = &cobra.Command{
 	Use:   "validate [FILE]",
 	Short: "compare vulnerabilities to configured thresholds",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MaximumNArgs(1),
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		configFilename := RuntimeConfig.ConfigFilename.Value().(string)
 
@@ -42,25 +43,49 @@ var validateCmd = &cobra.Command{
 			return err
 		}
 
+		// The report type is detected from content, input-type is an optional override
+		if len(args) == 0 {
+			slog.Debug("read target from stdin")
+			RuntimeConfig.validateSrcReader = cmd.InOrStdin()
+			RuntimeConfig.validateSrcName = "stdin"
+			return nil
+		}
+
 		targetFilename := args[0]
 		slog.Debug("open target file", "filename", targetFilename)
 		RuntimeConfig.targetFile, err = os.Open(targetFilename)
 		if err != nil {
 			return err
 		}
+		RuntimeConfig.validateSrcReader = RuntimeConfig.targetFile
+		RuntimeConfig.validateSrcName = targetFilename
 
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		inputType, _ := cmd.Flags().GetString("input-type")
+		output, _ := cmd.Flags().GetString("output")
+
+		// nil writes no output, validation results are only logged
+		var sarifOutput io.Writer
+		switch output {
+		case "":
+		case "sarif":
+			sarifOutput = cmd.OutOrStdout()
+		default:
+			return fmt.Errorf("unsupported output format '%s', supported: [sarif]", output)
+		}
 
 		err := gatecheck.Validate(
 			RuntimeConfig.gatecheckConfig,
-			RuntimeConfig.targetFile,
-			args[0],
+			RuntimeConfig.validateSrcReader,
+			RuntimeConfig.validateSrcName,
 			gatecheck.WithEPSSURL(RuntimeConfig.EPSSURL.Value().(string)),
 			gatecheck.WithKEVURL(RuntimeConfig.KEVURL.Value().(string)),
 			gatecheck.WithEPSSFile(RuntimeConfig.epssFile),
 			gatecheck.WithKEVFile(RuntimeConfig.kevFile),
+			gatecheck.WithInputType(inputType),
+			gatecheck.WithSarifOutput(sarifOutput),
 		)
 
 		audit := RuntimeConfig.Audit.Value().(bool)
@@ -75,6 +100,8 @@ var validateCmd = &cobra.Command{
 }
 
 func newValidateCommand() *cobra.Command {
+	validateCmd.Flags().StringP("input-type", "i", "", inputTypeUsage)
+	validateCmd.Flags().StringP("output", "o", "", "write the findings to stdout in an output format [sarif]")
 
 	RuntimeConfig.ConfigFilename.SetupCobra(validateCmd)
 	RuntimeConfig.EPSSFilename.SetupCobra(validateCmd)
@@ -0,0 +1,25 @@
+package artifacts
+
+import "strings"
+
+// CVSS a single base score normalized from any report
+//
+// Version is "2.0", "3.0", "3.1" or "4.0", "" if it couldn't be determined
+type CVSS struct {
+	Version string
+	Score   float64
+	Vector  string
+	Source  string
+}
+
+// cvssVersionFromVector CVSS 3 and 4 vectors start with the version, CVSS 2 vectors don't have a prefix
+func cvssVersionFromVector(vector string) string {
+	if version, ok := strings.CutPrefix(vector, "CVSS:"); ok {
+		version, _, _ = strings.Cut(version, "/")
+		return version
+	}
+	if strings.HasPrefix(vector, "AV:") {
+		return "2.0"
+	}
+	return ""
+}
@@ -1,67 +1,177 @@
 package artifacts
 
 import (
+	"bytes"
 	"cmp"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
 	"slices"
 	"strings"
 )
 
+// CyclonedxNamespace the XML namespace prefix shared by every CycloneDX schema version
+const CyclonedxNamespace = "http://cyclonedx.org/schema/bom/"
+
 // CyclonedxReportMin is a minimum representation of an Cyclonedx scan report
 //
-// It contains only the necessary fields for validation and listing
+// It contains only the necessary fields for validation and listing.
+// Decodes from either JSON or XML, see DecodeCyclonedx
 type CyclonedxReportMin struct {
-	Components      []CyclonedxComponent     `json:"components"`
-	Vulnerabilities []CyclonedxVulnerability `json:"vulnerabilities"`
+	Components      []CyclonedxComponent     `json:"components" xml:"components>component"`
+	Vulnerabilities []CyclonedxVulnerability `json:"vulnerabilities" xml:"vulnerabilities>vulnerability"`
 }
 
 type CyclonedxComponent struct {
-	BOMRef  string `json:"bom-ref"`
-	Name    string `json:"name"`
-	Version string `json:"version"`
+	BOMRef  string `json:"bom-ref" xml:"bom-ref,attr"`
+	Name    string `json:"name" xml:"name"`
+	Version string `json:"version" xml:"version"`
+	Purl    string `json:"purl" xml:"purl"`
 }
 
 type CyclonedxVulnerability struct {
-	ID         string                     `json:"id"`
-	Advisories []CyclonedxAdvisory        `json:"advisories"`
-	Affects    []CyclondexAffectedPackage `json:"affects"`
-	Ratings    []CyclonedxRating          `json:"ratings"`
+	ID             string                     `json:"id" xml:"id"`
+	Advisories     []CyclonedxAdvisory        `json:"advisories" xml:"advisories>advisory"`
+	Affects        []CyclondexAffectedPackage `json:"affects" xml:"affects>target"`
+	Ratings        []CyclonedxRating          `json:"ratings" xml:"ratings>rating"`
+	Recommendation string                     `json:"recommendation" xml:"recommendation"`
+	Analysis       CyclonedxAnalysis          `json:"analysis" xml:"analysis"`
 }
 
 type CyclondexAffectedPackage struct {
-	Ref string `json:"ref"`
+	Ref      string                     `json:"ref" xml:"ref"`
+	Versions []CyclonedxAffectedVersion `json:"versions" xml:"versions>version"`
+}
+
+// CyclonedxAffectedVersion status is affected, unaffected or unknown, an unaffected version is a fix
+type CyclonedxAffectedVersion struct {
+	Version string `json:"version" xml:"version"`
+	Range   string `json:"range" xml:"range"`
+	Status  string `json:"status" xml:"status"`
+}
+
+type CyclonedxAnalysis struct {
+	State    string   `json:"state" xml:"state"`
+	Response []string `json:"response" xml:"responses>response"`
 }
 
 type CyclonedxAdvisory struct {
-	URL string `json:"url"`
+	URL string `json:"url" xml:"url"`
 }
 
 type CyclonedxRating struct {
-	Source   CyclonedxSource `json:"source"`
-	Severity string          `json:"severity"`
+	Source   CyclonedxSource `json:"source" xml:"source"`
+	Score    float64         `json:"score" xml:"score"`
+	Severity string          `json:"severity" xml:"severity"`
+	// Method CVSSv2, CVSSv3, CVSSv31, CVSSv4, OWASP, SSVC or other
+	Method string `json:"method" xml:"method"`
+	Vector string `json:"vector" xml:"vector"`
 }
 
 type CyclonedxSource struct {
-	Name string `json:"name"`
+	Name string `json:"name" xml:"name"`
+}
+
+// DecodeCyclonedx decode a JSON or XML BOM, XML is selected when the content starts with '<'
+func DecodeCyclonedx(r io.Reader, report *CyclonedxReportMin) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("<")) {
+		return xml.Unmarshal(content, report)
+	}
+	return json.Unmarshal(content, report)
+}
+
+// CyclonedxSeverityUnknown the severity of a vulnerability without a rated severity
+const CyclonedxSeverityUnknown = "unknown"
+
+const (
+	// CyclonedxRatingFallbackHighest the highest severity of every rating
+	CyclonedxRatingFallbackHighest = "highest"
+	// CyclonedxRatingFallbackFirst the first rating in the report
+	CyclonedxRatingFallbackFirst = "first"
+)
+
+// CyclonedxRatingPreference selects the rating used for the severity of a vulnerability
+//
+// Sources are rating source names in order of preference, case insensitive.
+// Fallback is used if none of the sources rated the vulnerability, it is highest, first or a source name.
+// A source name fallback that didn't rate the vulnerability falls back to highest, as does an empty fallback
+type CyclonedxRatingPreference struct {
+	Sources  []string
+	Fallback string
 }
 
 func (r *CyclonedxReportMin) SelectBySeverity(severity string) []CyclonedxVulnerability {
+	return r.SelectByPreferredSeverity(severity, CyclonedxRatingPreference{})
+}
+
+// SelectByPreferredSeverity case insensitive match on the severity selected by the preference
+func (r *CyclonedxReportMin) SelectByPreferredSeverity(severity string, preference CyclonedxRatingPreference) []CyclonedxVulnerability {
 	vulnerabilities := []CyclonedxVulnerability{}
 
 	for _, vulnerability := range r.Vulnerabilities {
-		if strings.EqualFold(vulnerability.HighestSeverity(), severity) {
+		if strings.EqualFold(vulnerability.Severity(preference), severity) {
 			vulnerabilities = append(vulnerabilities, vulnerability)
 		}
 	}
 	return vulnerabilities
 }
 
+// HighestSeverity the highest severity of every rating, unknown if none of the ratings have a severity
 func (r *CyclonedxVulnerability) HighestSeverity() string {
-	order := map[string]int{"none": 0, "low": 1, "medium": 2, "high": 3, "critical": 4}
-	rating := slices.MaxFunc(r.Ratings, func(a, b CyclonedxRating) int {
-		return cmp.Compare(order[a.Severity], order[b.Severity])
+	return r.Severity(CyclonedxRatingPreference{})
+}
+
+// Severity the severity of the preferred rating, unknown if none of the ratings have a severity
+//
+// A source with more than one rating uses the highest of its ratings
+func (r *CyclonedxVulnerability) Severity(preference CyclonedxRatingPreference) string {
+	ratings := slices.DeleteFunc(slices.Clone(r.Ratings), func(rating CyclonedxRating) bool {
+		severity := strings.TrimSpace(rating.Severity)
+		return severity == "" || strings.EqualFold(severity, CyclonedxSeverityUnknown)
+	})
+	if len(ratings) == 0 {
+		return CyclonedxSeverityUnknown
+	}
+
+	for _, source := range preference.Sources {
+		if severity, ok := cyclonedxSourceSeverity(ratings, source); ok {
+			return severity
+		}
+	}
+
+	switch strings.ToLower(strings.TrimSpace(preference.Fallback)) {
+	case "", CyclonedxRatingFallbackHighest:
+	case CyclonedxRatingFallbackFirst:
+		return ratings[0].Severity
+	default:
+		if severity, ok := cyclonedxSourceSeverity(ratings, preference.Fallback); ok {
+			return severity
+		}
+	}
+	return cyclonedxHighestRating(ratings).Severity
+}
+
+func cyclonedxSourceSeverity(ratings []CyclonedxRating, source string) (string, bool) {
+	sourceRatings := slices.DeleteFunc(slices.Clone(ratings), func(rating CyclonedxRating) bool {
+		return !strings.EqualFold(strings.TrimSpace(rating.Source.Name), strings.TrimSpace(source))
+	})
+	if len(sourceRatings) == 0 {
+		return "", false
+	}
+	return cyclonedxHighestRating(sourceRatings).Severity, true
+}
+
+func cyclonedxHighestRating(ratings []CyclonedxRating) CyclonedxRating {
+	order := map[string]int{"none": 0, "info": 0, "low": 1, "medium": 2, "high": 3, "critical": 4}
+	return slices.MaxFunc(ratings, func(a, b CyclonedxRating) int {
+		return cmp.Compare(order[strings.ToLower(a.Severity)], order[strings.ToLower(b.Severity)])
 	})
-	return rating.Severity
 }
 
 func (r CyclonedxReportMin) AffectedPackages(vulnerabilityIndex int) string {
@@ -83,3 +193,96 @@ func (r CyclonedxReportMin) AffectedPackages(vulnerabilityIndex int) string {
 
 	return strings.Join(pkgs, ", ")
 }
+
+// AffectedComponents the components linked to each affected ref
+//
+// A ref without a matching component is returned as a component with only the ref,
+// the ref is also used as the purl if it is one
+func (r CyclonedxReportMin) AffectedComponents(vulnerability CyclonedxVulnerability) []CyclonedxComponent {
+	components := []CyclonedxComponent{}
+	for _, affected := range vulnerability.Affects {
+		idx := slices.IndexFunc(r.Components, func(component CyclonedxComponent) bool {
+			return component.BOMRef == affected.Ref
+		})
+		if idx >= 0 {
+			components = append(components, r.Components[idx])
+			continue
+		}
+		component := CyclonedxComponent{BOMRef: affected.Ref}
+		if strings.HasPrefix(affected.Ref, "pkg:") {
+			component.Purl = affected.Ref
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// FixedVersions every unaffected version of the affected packages
+func (r *CyclonedxVulnerability) FixedVersions() []string {
+	versions := []string{}
+	for _, affected := range r.Affects {
+		for _, version := range affected.Versions {
+			if strings.EqualFold(version.Status, "unaffected") && version.Version != "" && !slices.Contains(versions, version.Version) {
+				versions = append(versions, version.Version)
+			}
+		}
+	}
+	return versions
+}
+
+// FixState wont-fix if the analysis response is will_not_fix,
+// fixed if there is an unaffected version, a recommendation or an update response, unknown otherwise
+func (r *CyclonedxVulnerability) FixState() string {
+	if slices.Contains(r.Analysis.Response, "will_not_fix") {
+		return FixStateWontFix
+	}
+	if len(r.FixedVersions()) > 0 || strings.TrimSpace(r.Recommendation) != "" || slices.Contains(r.Analysis.Response, "update") {
+		return FixStateFixed
+	}
+	return FixStateUnknown
+}
+
+// CVSSScores every rating with a CVSS method or vector and a score
+func (r *CyclonedxVulnerability) CVSSScores() []CVSS {
+	methodVersions := map[string]string{"CVSSv2": "2.0", "CVSSv3": "3.0", "CVSSv31": "3.1", "CVSSv4": "4.0"}
+	scores := []CVSS{}
+	for _, rating := range r.Ratings {
+		version, ok := methodVersions[rating.Method]
+		if !ok {
+			version = cvssVersionFromVector(rating.Vector)
+		}
+		if version == "" || rating.Score == 0 {
+			continue
+		}
+		scores = append(scores, CVSS{Version: version, Score: rating.Score, Vector: rating.Vector, Source: rating.Source.Name})
+	}
+	return scores
+}
+
+// NormalizedFindings one finding for each vulnerability with the severity selected by the preference
+//
+// The packages are the affected components, the version is taken from the purl if the component doesn't have one
+func (r *CyclonedxReportMin) NormalizedFindings(preference CyclonedxRatingPreference) []Finding {
+	findings := make([]Finding, 0, len(r.Vulnerabilities))
+	for _, vulnerability := range r.Vulnerabilities {
+		packages := []FindingPackage{}
+		for _, component := range r.AffectedComponents(vulnerability) {
+			pkg := FindingPackage{Name: component.Name, Version: component.Version, Purl: component.Purl}
+			if pkg.Version == "" {
+				pkg.Version = purlVersion(component.Purl)
+			}
+			packages = append(packages, pkg)
+		}
+		findings = append(findings, Finding{
+			ID:            vulnerability.ID,
+			Severity:      findingSeverity(vulnerability.Severity(preference)),
+			Packages:      packages,
+			SourceTool:    "cyclonedx",
+			FixState:      vulnerability.FixState(),
+			FixedVersions: vulnerability.FixedVersions(),
+			CVSS:          vulnerability.CVSSScores(),
+			Raw:           vulnerability,
+		})
+	}
+	return findings
+}
@@ -0,0 +1,114 @@
+package artifacts
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FindingSeverityUnknown the severity of a finding the tool didn't rate
+const FindingSeverityUnknown = "unknown"
+
+// Finding a single result normalized from any report type
+//
+// Rules written against a Finding work for every report that produces one.
+// Findings are returned in report order so they can be zipped with the report items they came from
+type Finding struct {
+	// ID the tool's primary ID, for example a CVE, GHSA, rule or check ID
+	ID string
+	// Aliases other IDs for the same finding, for example the CVE of a GHSA
+	Aliases []string
+	// Severity lowercase on the tool's own scale, "unknown" if the tool didn't rate the finding
+	Severity string
+	// Packages the affected packages, empty if the finding isn't in a package
+	Packages []FindingPackage
+	// Location a file, file:line, target or URI, "" if the report doesn't locate findings
+	Location string
+	// SourceTool the tool or report type that produced the finding, for example "grype"
+	SourceTool string
+	// FixState fixed, not-fixed, wont-fix or unknown, "" if the finding isn't a vulnerability
+	FixState      string
+	FixedVersions []string
+	CVSS          []CVSS
+	// Raw the decoded report item, for example a GrypeMatch, for rules that need tool specific fields
+	Raw any
+}
+
+// FindingPackage a package a finding was reported in
+type FindingPackage struct {
+	Name    string
+	Version string
+	Purl    string
+	// Type the tool's package type, for example "deb", "npm" or "go-module"
+	Type string
+}
+
+// IDs the primary ID and every alias
+func (f *Finding) IDs() []string {
+	return append([]string{f.ID}, f.Aliases...)
+}
+
+// HasID case insensitive match of the primary ID or any alias
+func (f *Finding) HasID(id string) bool {
+	return slices.ContainsFunc(f.IDs(), func(findingID string) bool {
+		return strings.EqualFold(findingID, id)
+	})
+}
+
+// CVE the primary ID if it is a CVE, otherwise the first CVE alias, the primary ID if there is no CVE alias
+//
+// Use this ID for EPSS and KEV lookups
+func (f *Finding) CVE() string {
+	for _, id := range f.IDs() {
+		if strings.HasPrefix(strings.ToUpper(id), "CVE-") {
+			return id
+		}
+	}
+	return f.ID
+}
+
+// Key the ID with the packages and location, unique in a report where the same ID is found in more than one package
+func (f *Finding) Key() string {
+	key := f.ID
+	if len(f.Packages) > 0 {
+		key += " " + f.PackagesShort()
+	}
+	if f.Location != "" {
+		key += " " + f.Location
+	}
+	return key
+}
+
+// PackagesShort name@version of every package comma separated, "-" if there are no packages
+func (f *Finding) PackagesShort() string {
+	if len(f.Packages) == 0 {
+		return "-"
+	}
+	pkgs := []string{}
+	for _, pkg := range f.Packages {
+		if pkg.Version == "" {
+			pkgs = append(pkgs, pkg.Name)
+			continue
+		}
+		pkgs = append(pkgs, fmt.Sprintf("%s@%s", pkg.Name, pkg.Version))
+	}
+	return strings.Join(pkgs, ", ")
+}
+
+// findingSeverity lowercase and trimmed, "unknown" if empty
+func findingSeverity(severity string) string {
+	severity = strings.ToLower(strings.TrimSpace(severity))
+	if severity == "" {
+		return FindingSeverityUnknown
+	}
+	return severity
+}
+
+// purlVersion the version of a purl without qualifiers or subpath, "" if it doesn't have one
+func purlVersion(purl string) string {
+	purl, _, _ = strings.Cut(strings.TrimSpace(purl), "#")
+	purl, _, _ = strings.Cut(purl, "?")
+	nameStart := strings.LastIndex(purl, "/") + 1
+	_, version, _ := strings.Cut(purl[nameStart:], "@")
+	return version
+}
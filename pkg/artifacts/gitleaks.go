@@ -1,6 +1,8 @@
 package artifacts
 
 import (
+	"fmt"
+
 	"github.com/gatecheckdev/gatecheck/pkg/format"
 )
 
@@ -15,16 +17,40 @@ func (r *GitLeaksReportMin) Count() int {
 }
 
 type GitleaksFinding struct {
-	RuleID    string `json:"RuleID"`
-	File      string `json:"File"`
-	Commit    string `json:"Commit"`
-	StartLine int    `json:"StartLine"`
+	RuleID      string  `json:"RuleID"`
+	Description string  `json:"Description"`
+	File        string  `json:"File"`
+	Commit      string  `json:"Commit"`
+	StartLine   int     `json:"StartLine"`
+	Fingerprint string  `json:"Fingerprint"`
+	Author      string  `json:"Author"`
+	Date        string  `json:"Date"`
+	Entropy     float64 `json:"Entropy"`
 }
 
 func (f *GitleaksFinding) FileShort() string {
 	return format.Summarize(f.File, 50, format.ClipMiddle)
 }
 
+// CommitShort the first 8 characters of the commit, "-" for --no-git scans
 func (f *GitleaksFinding) CommitShort() string {
-	return f.Commit[:8]
+	if f.Commit == "" {
+		return "-"
+	}
+	return f.Commit[:min(8, len(f.Commit))]
+}
+
+// NormalizedFindings one finding for each secret, gitleaks doesn't rate secrets so the severity is unknown
+func (r *GitLeaksReportMin) NormalizedFindings() []Finding {
+	findings := make([]Finding, 0, len(*r))
+	for _, finding := range *r {
+		findings = append(findings, Finding{
+			ID:         finding.RuleID,
+			Severity:   FindingSeverityUnknown,
+			Location:   fmt.Sprintf("%s:%d", finding.File, finding.StartLine),
+			SourceTool: "gitleaks",
+			Raw:        finding,
+		})
+	}
+	return findings
 }
@@ -0,0 +1,215 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Govulncheck finding levels, from the trace depth of the finding
+const (
+	GovulncheckLevelCalled   = "called"
+	GovulncheckLevelImported = "imported"
+	GovulncheckLevelRequired = "required"
+)
+
+// GovulncheckReportMin is a minimum representation of the govulncheck -json message stream
+//
+// The stream is a sequence of JSON objects, each with one of config, progress, osv or finding.
+// Only config, osv and finding messages are kept
+type GovulncheckReportMin struct {
+	Config   GovulncheckConfig
+	OSVs     []OsvVulnerability
+	Findings []GovulncheckFinding
+}
+
+type GovulncheckConfig struct {
+	ProtocolVersion string `json:"protocol_version"`
+	ScannerName     string `json:"scanner_name"`
+	ScannerVersion  string `json:"scanner_version"`
+	GoVersion       string `json:"go_version"`
+	ScanLevel       string `json:"scan_level"`
+}
+
+type GovulncheckFinding struct {
+	OSV          string             `json:"osv"`
+	FixedVersion string             `json:"fixed_version"`
+	Trace        []GovulncheckFrame `json:"trace"`
+}
+
+// GovulncheckFrame a single frame, the first frame in a trace is the vulnerable module, package or symbol
+type GovulncheckFrame struct {
+	Module   string               `json:"module"`
+	Version  string               `json:"version"`
+	Package  string               `json:"package"`
+	Function string               `json:"function"`
+	Receiver string               `json:"receiver"`
+	Position *GovulncheckPosition `json:"position"`
+}
+
+type GovulncheckPosition struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+}
+
+// GovulncheckVulnerability every finding for a single OSV entry, at the highest level reached
+type GovulncheckVulnerability struct {
+	OSV          OsvVulnerability
+	Level        string
+	Module       string
+	Version      string
+	FixedVersion string
+	// Symbol the vulnerable function, "" unless the level is called
+	Symbol string
+	// Call the outermost frame of the first called trace, nil unless the level is called
+	Call *GovulncheckFrame
+}
+
+type govulncheckMessage struct {
+	Config  *GovulncheckConfig  `json:"config"`
+	OSV     *OsvVulnerability   `json:"osv"`
+	Finding *GovulncheckFinding `json:"finding"`
+}
+
+// DecodeGovulncheck decode every message in the stream until EOF
+func DecodeGovulncheck(r io.Reader, report *GovulncheckReportMin) error {
+	decoder := json.NewDecoder(r)
+	for {
+		message := govulncheckMessage{}
+		err := decoder.Decode(&message)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case message.Config != nil:
+			report.Config = *message.Config
+		case message.OSV != nil:
+			report.OSVs = append(report.OSVs, *message.OSV)
+		case message.Finding != nil:
+			report.Findings = append(report.Findings, *message.Finding)
+		}
+	}
+}
+
+// Level called if the vulnerable frame is a function, imported if it is a package, otherwise required
+func (f *GovulncheckFinding) Level() string {
+	if len(f.Trace) == 0 {
+		return GovulncheckLevelRequired
+	}
+	switch {
+	case f.Trace[0].Function != "":
+		return GovulncheckLevelCalled
+	case f.Trace[0].Package != "":
+		return GovulncheckLevelImported
+	}
+	return GovulncheckLevelRequired
+}
+
+// Symbol the function with the receiver, for example "(*Server).ServeHTTP"
+func (f *GovulncheckFrame) Symbol() string {
+	if f.Receiver == "" {
+		return f.Function
+	}
+	return fmt.Sprintf("(%s).%s", f.Receiver, f.Function)
+}
+
+// PositionShort filename:line, "-" if there is no position
+func (f *GovulncheckFrame) PositionShort() string {
+	if f.Position == nil || f.Position.Filename == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%s:%d", f.Position.Filename, f.Position.Line)
+}
+
+// Vulnerabilities the findings grouped by OSV ID in stream order
+//
+// govulncheck emits a finding at each level reached, the highest level is kept
+func (r *GovulncheckReportMin) Vulnerabilities() []GovulncheckVulnerability {
+	order := map[string]int{GovulncheckLevelRequired: 0, GovulncheckLevelImported: 1, GovulncheckLevelCalled: 2}
+	vulnerabilities := []GovulncheckVulnerability{}
+
+	for _, finding := range r.Findings {
+		idx := slices.IndexFunc(vulnerabilities, func(v GovulncheckVulnerability) bool {
+			return v.OSV.ID == finding.OSV
+		})
+		if idx < 0 {
+			vulnerabilities = append(vulnerabilities, GovulncheckVulnerability{
+				OSV:   r.osv(finding.OSV),
+				Level: GovulncheckLevelRequired,
+			})
+			idx = len(vulnerabilities) - 1
+		}
+
+		vulnerability := &vulnerabilities[idx]
+		if len(finding.Trace) > 0 && vulnerability.Module == "" {
+			vulnerability.Module = finding.Trace[0].Module
+			vulnerability.Version = finding.Trace[0].Version
+		}
+		if vulnerability.FixedVersion == "" {
+			vulnerability.FixedVersion = finding.FixedVersion
+		}
+
+		level := finding.Level()
+		if order[level] <= order[vulnerability.Level] {
+			continue
+		}
+		vulnerability.Level = level
+		if level == GovulncheckLevelCalled {
+			call := finding.Trace[len(finding.Trace)-1]
+			vulnerability.Call = &call
+			vulnerability.Symbol = finding.Trace[0].Symbol()
+		}
+	}
+
+	return vulnerabilities
+}
+
+// osv the OSV entry for the ID, only the ID is set if the stream has no entry
+func (r *GovulncheckReportMin) osv(id string) OsvVulnerability {
+	for _, entry := range r.OSVs {
+		if entry.ID == id {
+			return entry
+		}
+	}
+	return OsvVulnerability{ID: id}
+}
+
+// Finding the vulnerability normalized, the location is the call position, "" unless the level is called
+func (v *GovulncheckVulnerability) Finding() Finding {
+	finding := Finding{
+		ID:       v.OSV.ID,
+		Aliases:  v.OSV.Aliases,
+		Severity: v.OSV.Severity(),
+		Packages: []FindingPackage{{
+			Name:    v.Module,
+			Version: v.Version,
+			Type:    "go-module",
+		}},
+		SourceTool: "govulncheck",
+		FixState:   FixStateNotFixed,
+		Raw:        *v,
+	}
+	if v.FixedVersion != "" {
+		finding.FixState = FixStateFixed
+		finding.FixedVersions = []string{v.FixedVersion}
+	}
+	if v.Call != nil {
+		finding.Location = v.Call.PositionShort()
+	}
+	return finding
+}
+
+// NormalizedFindings one finding for each vulnerability at the highest level reached
+func (r *GovulncheckReportMin) NormalizedFindings() []Finding {
+	findings := []Finding{}
+	for _, vulnerability := range r.Vulnerabilities() {
+		findings = append(findings, vulnerability.Finding())
+	}
+	return findings
+}
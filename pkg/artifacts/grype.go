@@ -1,6 +1,9 @@
 package artifacts
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
 
 // GrypeReportMin is a minimum representation of an Anchore Grype scan report
 //
@@ -11,8 +14,9 @@ type GrypeReportMin struct {
 }
 
 type GrypeMatch struct {
-	Artifact      GrypeArtifact      `json:"artifact"`
-	Vulnerability GrypeVulnerability `json:"vulnerability"`
+	Artifact               GrypeArtifact               `json:"artifact"`
+	Vulnerability          GrypeVulnerability          `json:"vulnerability"`
+	RelatedVulnerabilities []GrypeRelatedVulnerability `json:"relatedVulnerabilities"`
 }
 
 type GrypeDescriptor struct {
@@ -21,14 +25,61 @@ type GrypeDescriptor struct {
 }
 
 type GrypeArtifact struct {
-	Name    string `json:"name"`
-	Version string `json:"version"`
+	Name      string          `json:"name"`
+	Version   string          `json:"version"`
+	Type      string          `json:"type"`
+	Purl      string          `json:"purl"`
+	Locations []GrypeLocation `json:"locations"`
+}
+
+type GrypeLocation struct {
+	Path string `json:"path"`
 }
 
+// Fix states use grype's values, other reports are normalized to them
+const (
+	FixStateFixed    = "fixed"
+	FixStateNotFixed = "not-fixed"
+	FixStateWontFix  = "wont-fix"
+	FixStateUnknown  = "unknown"
+)
+
 type GrypeVulnerability struct {
-	ID         string `json:"id"`
-	Severity   string `json:"severity"`
-	DataSource string `json:"dataSource"`
+	ID         string      `json:"id"`
+	Severity   string      `json:"severity"`
+	DataSource string      `json:"dataSource"`
+	Fix        GrypeFix    `json:"fix"`
+	CVSS       []GrypeCVSS `json:"cvss"`
+}
+
+// GrypeRelatedVulnerability the same vulnerability from another namespace, usually NVD
+type GrypeRelatedVulnerability struct {
+	ID        string      `json:"id"`
+	Namespace string      `json:"namespace"`
+	CVSS      []GrypeCVSS `json:"cvss"`
+}
+
+type GrypeCVSS struct {
+	Source  string `json:"source"`
+	Version string `json:"version"`
+	Vector  string `json:"vector"`
+	Metrics struct {
+		BaseScore float64 `json:"baseScore"`
+	} `json:"metrics"`
+}
+
+type GrypeFix struct {
+	Versions []string `json:"versions"`
+	State    string   `json:"state"`
+}
+
+// FixState fixed, not-fixed, wont-fix or unknown, unknown if grype didn't set a state
+func (v *GrypeVulnerability) FixState() string {
+	switch state := strings.ToLower(strings.TrimSpace(v.Fix.State)); state {
+	case FixStateFixed, FixStateNotFixed, FixStateWontFix:
+		return state
+	}
+	return FixStateUnknown
 }
 
 func (g *GrypeReportMin) SelectBySeverity(severity string) []GrypeMatch {
@@ -41,3 +92,68 @@ func (g *GrypeReportMin) SelectBySeverity(severity string) []GrypeMatch {
 
 	return matches
 }
+
+// CVSSScores the vulnerability's scores, the related vulnerability scores if the vulnerability has none
+//
+// Distro namespaces often don't have scores, the NVD related vulnerability does
+func (m *GrypeMatch) CVSSScores() []CVSS {
+	scores := grypeCVSSScores(m.Vulnerability.CVSS, m.Vulnerability.DataSource)
+	if len(scores) > 0 {
+		return scores
+	}
+	for _, related := range m.RelatedVulnerabilities {
+		scores = append(scores, grypeCVSSScores(related.CVSS, related.Namespace)...)
+	}
+	return scores
+}
+
+func grypeCVSSScores(items []GrypeCVSS, defaultSource string) []CVSS {
+	scores := []CVSS{}
+	for _, item := range items {
+		version := item.Version
+		if version == "" {
+			version = cvssVersionFromVector(item.Vector)
+		}
+		source := item.Source
+		if source == "" {
+			source = defaultSource
+		}
+		scores = append(scores, CVSS{Version: version, Score: item.Metrics.BaseScore, Vector: item.Vector, Source: source})
+	}
+	return scores
+}
+
+// NormalizedFindings one finding for each match, related vulnerability IDs are aliases
+func (g *GrypeReportMin) NormalizedFindings() []Finding {
+	findings := make([]Finding, 0, len(g.Matches))
+	for _, match := range g.Matches {
+		aliases := []string{}
+		for _, related := range match.RelatedVulnerabilities {
+			if !strings.EqualFold(related.ID, match.Vulnerability.ID) && !slices.Contains(aliases, related.ID) {
+				aliases = append(aliases, related.ID)
+			}
+		}
+		location := ""
+		if len(match.Artifact.Locations) > 0 {
+			location = match.Artifact.Locations[0].Path
+		}
+		findings = append(findings, Finding{
+			ID:       match.Vulnerability.ID,
+			Aliases:  aliases,
+			Severity: findingSeverity(match.Vulnerability.Severity),
+			Packages: []FindingPackage{{
+				Name:    match.Artifact.Name,
+				Version: match.Artifact.Version,
+				Purl:    match.Artifact.Purl,
+				Type:    match.Artifact.Type,
+			}},
+			Location:      location,
+			SourceTool:    "grype",
+			FixState:      match.Vulnerability.FixState(),
+			FixedVersions: match.Vulnerability.Fix.Versions,
+			CVSS:          match.CVSSScores(),
+			Raw:           match,
+		})
+	}
+	return findings
+}
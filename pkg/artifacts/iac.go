@@ -0,0 +1,179 @@
+package artifacts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// IacReportMin infrastructure as code findings normalized from a Checkov or tfsec report
+type IacReportMin struct {
+	Tool     string
+	Findings []IacFinding
+}
+
+// IacFinding a failed check on a single resource
+type IacFinding struct {
+	// Tool checkov or tfsec
+	Tool        string
+	CheckID     string
+	Description string
+	// Severity critical, high, medium or low, "unknown" if the tool didn't set one
+	Severity  string
+	Resource  string
+	File      string
+	StartLine int
+	EndLine   int
+	Link      string
+}
+
+// CheckovReportMin is a minimum representation of a Checkov JSON report for a single framework
+//
+// Checkov writes an array of reports when more than one framework is scanned
+type CheckovReportMin struct {
+	CheckType string `json:"check_type"`
+	Results   struct {
+		FailedChecks []CheckovCheck `json:"failed_checks"`
+	} `json:"results"`
+}
+
+type CheckovCheck struct {
+	CheckID       string  `json:"check_id"`
+	CheckName     string  `json:"check_name"`
+	Severity      *string `json:"severity"`
+	Resource      string  `json:"resource"`
+	FilePath      string  `json:"file_path"`
+	FileLineRange []int   `json:"file_line_range"`
+	Guideline     string  `json:"guideline"`
+}
+
+// TfsecReportMin is a minimum representation of a tfsec JSON report
+type TfsecReportMin struct {
+	Results []TfsecResult `json:"results"`
+}
+
+type TfsecResult struct {
+	RuleID          string   `json:"rule_id"`
+	LongID          string   `json:"long_id"`
+	RuleDescription string   `json:"rule_description"`
+	Severity        string   `json:"severity"`
+	Resource        string   `json:"resource"`
+	Links           []string `json:"links"`
+	Location        struct {
+		Filename  string `json:"filename"`
+		StartLine int    `json:"start_line"`
+		EndLine   int    `json:"end_line"`
+	} `json:"location"`
+}
+
+// DecodeCheckov decode a single Checkov report or an array of reports into normalized findings
+func DecodeCheckov(r io.Reader, report *IacReportMin) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	checkovReports := []CheckovReportMin{}
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("[")) {
+		err = json.Unmarshal(content, &checkovReports)
+	} else {
+		checkovReport := CheckovReportMin{}
+		err = json.Unmarshal(content, &checkovReport)
+		checkovReports = append(checkovReports, checkovReport)
+	}
+	if err != nil {
+		return err
+	}
+
+	report.Tool = "checkov"
+	for _, checkovReport := range checkovReports {
+		for _, check := range checkovReport.Results.FailedChecks {
+			finding := IacFinding{
+				Tool:        report.Tool,
+				CheckID:     check.CheckID,
+				Description: check.CheckName,
+				Severity:    iacSeverity(check.Severity),
+				Resource:    check.Resource,
+				File:        check.FilePath,
+				Link:        check.Guideline,
+			}
+			if len(check.FileLineRange) == 2 {
+				finding.StartLine, finding.EndLine = check.FileLineRange[0], check.FileLineRange[1]
+			}
+			report.Findings = append(report.Findings, finding)
+		}
+	}
+	return nil
+}
+
+// DecodeTfsec decode a tfsec report into normalized findings
+func DecodeTfsec(r io.Reader, report *IacReportMin) error {
+	tfsecReport := TfsecReportMin{}
+	if err := json.NewDecoder(r).Decode(&tfsecReport); err != nil {
+		return err
+	}
+
+	report.Tool = "tfsec"
+	for _, result := range tfsecReport.Results {
+		finding := IacFinding{
+			Tool:        report.Tool,
+			CheckID:     result.RuleID,
+			Description: result.RuleDescription,
+			Severity:    iacSeverity(&result.Severity),
+			Resource:    result.Resource,
+			File:        result.Location.Filename,
+			StartLine:   result.Location.StartLine,
+			EndLine:     result.Location.EndLine,
+		}
+		if len(result.Links) > 0 {
+			finding.Link = result.Links[0]
+		}
+		report.Findings = append(report.Findings, finding)
+	}
+	return nil
+}
+
+// LocationShort file:start-end, the file alone if there are no lines
+func (f *IacFinding) LocationShort() string {
+	if f.StartLine == 0 {
+		return f.File
+	}
+	if f.EndLine <= f.StartLine {
+		return fmt.Sprintf("%s:%d", f.File, f.StartLine)
+	}
+	return fmt.Sprintf("%s:%d-%d", f.File, f.StartLine, f.EndLine)
+}
+
+// iacSeverity Checkov only sets a severity with a Prisma Cloud API key
+func iacSeverity(severity *string) string {
+	if severity == nil {
+		return "unknown"
+	}
+	switch s := strings.ToLower(strings.TrimSpace(*severity)); s {
+	case "critical", "high", "medium", "low":
+		return s
+	}
+	return "unknown"
+}
+
+// Finding the failed check normalized, the source tool is checkov or tfsec
+func (f *IacFinding) Finding() Finding {
+	return Finding{
+		ID:         f.CheckID,
+		Severity:   findingSeverity(f.Severity),
+		Location:   f.LocationShort(),
+		SourceTool: f.Tool,
+		Raw:        *f,
+	}
+}
+
+// NormalizedFindings one finding for each failed check
+func (r *IacReportMin) NormalizedFindings() []Finding {
+	findings := make([]Finding, 0, len(r.Findings))
+	for _, finding := range r.Findings {
+		findings = append(findings, finding.Finding())
+	}
+	return findings
+}
@@ -0,0 +1,174 @@
+package artifacts
+
+import (
+	"slices"
+	"strings"
+)
+
+// OsvReportMin is a minimum representation of an OSV-Scanner JSON report
+//
+// It contains only the necessary fields for validation and listing
+type OsvReportMin struct {
+	Results []OsvResult `json:"results"`
+}
+
+type OsvResult struct {
+	Source   OsvSource          `json:"source"`
+	Packages []OsvPackageResult `json:"packages"`
+}
+
+type OsvSource struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+type OsvPackageResult struct {
+	Package         OsvPackage         `json:"package"`
+	Vulnerabilities []OsvVulnerability `json:"vulnerabilities"`
+}
+
+type OsvPackage struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+// OsvVulnerability an OSV record, the primary ID is often a GHSA, PYSEC or GO ID
+//
+// database_specific is a free form object that differs between databases
+type OsvVulnerability struct {
+	ID               string         `json:"id"`
+	Aliases          []string       `json:"aliases"`
+	Summary          string         `json:"summary"`
+	DatabaseSpecific map[string]any `json:"database_specific"`
+	Affected         []OsvAffected  `json:"affected"`
+}
+
+type OsvAffected struct {
+	Package OsvPackage `json:"package"`
+	Ranges  []OsvRange `json:"ranges"`
+}
+
+type OsvRange struct {
+	Type   string          `json:"type"`
+	Events []OsvRangeEvent `json:"events"`
+}
+
+type OsvRangeEvent struct {
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+}
+
+// CVE the primary ID if it is a CVE, otherwise the first CVE alias, the primary ID if there is no CVE alias
+//
+// Use this ID for EPSS and KEV lookups
+func (v *OsvVulnerability) CVE() string {
+	if strings.HasPrefix(strings.ToUpper(v.ID), "CVE-") {
+		return v.ID
+	}
+	for _, alias := range v.Aliases {
+		if strings.HasPrefix(strings.ToUpper(alias), "CVE-") {
+			return alias
+		}
+	}
+	return v.ID
+}
+
+// IDs the primary ID and every alias
+func (v *OsvVulnerability) IDs() []string {
+	return append([]string{v.ID}, v.Aliases...)
+}
+
+// HasID case insensitive match of the primary ID or any alias
+func (v *OsvVulnerability) HasID(id string) bool {
+	return slices.ContainsFunc(v.IDs(), func(vulID string) bool {
+		return strings.EqualFold(vulID, id)
+	})
+}
+
+// FixedVersions every fixed event in the affected ranges
+func (v *OsvVulnerability) FixedVersions() []string {
+	versions := []string{}
+	for _, affected := range v.Affected {
+		for _, r := range affected.Ranges {
+			for _, event := range r.Events {
+				if event.Fixed != "" && !slices.Contains(versions, event.Fixed) {
+					versions = append(versions, event.Fixed)
+				}
+			}
+		}
+	}
+	return versions
+}
+
+// FixState fixed if a range has a fixed event, not-fixed if there are ranges without one,
+// unknown if the record doesn't include affected ranges
+func (v *OsvVulnerability) FixState() string {
+	if len(v.FixedVersions()) > 0 {
+		return FixStateFixed
+	}
+	for _, affected := range v.Affected {
+		if len(affected.Ranges) > 0 {
+			return FixStateNotFixed
+		}
+	}
+	return FixStateUnknown
+}
+
+// Severity database_specific.severity normalized to critical, high, medium or low, "unknown" if not set
+//
+// GitHub advisories use "MODERATE" for medium
+func (v *OsvVulnerability) Severity() string {
+	severity, _ := v.DatabaseSpecific["severity"].(string)
+	switch strings.ToLower(severity) {
+	case "critical":
+		return "critical"
+	case "high":
+		return "high"
+	case "moderate", "medium":
+		return "medium"
+	case "low":
+		return "low"
+	}
+	return "unknown"
+}
+
+// AllVulnerabilities the vulnerabilities from every source and package in a single slice
+func (r *OsvReportMin) AllVulnerabilities() []OsvVulnerability {
+	vulnerabilities := []OsvVulnerability{}
+	for _, result := range r.Results {
+		for _, pkg := range result.Packages {
+			vulnerabilities = append(vulnerabilities, pkg.Vulnerabilities...)
+		}
+	}
+	return vulnerabilities
+}
+
+// NormalizedFindings one finding for each vulnerability in each package result
+//
+// The package type is the ecosystem, for example "npm" or "Go", and the location is the source path
+func (r *OsvReportMin) NormalizedFindings() []Finding {
+	findings := []Finding{}
+	for _, result := range r.Results {
+		for _, pkg := range result.Packages {
+			for _, vulnerability := range pkg.Vulnerabilities {
+				findings = append(findings, Finding{
+					ID:       vulnerability.ID,
+					Aliases:  vulnerability.Aliases,
+					Severity: vulnerability.Severity(),
+					Packages: []FindingPackage{{
+						Name:    pkg.Package.Name,
+						Version: pkg.Package.Version,
+						Type:    pkg.Package.Ecosystem,
+					}},
+					Location:      result.Source.Path,
+					SourceTool:    "osv-scanner",
+					FixState:      vulnerability.FixState(),
+					FixedVersions: vulnerability.FixedVersions(),
+					Raw:           vulnerability,
+				})
+			}
+		}
+	}
+	return findings
+}
@@ -0,0 +1,242 @@
+package artifacts
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gatecheckdev/gatecheck/pkg/format"
+)
+
+// SarifVersion the only supported version of the SARIF specification
+const SarifVersion = "2.1.0"
+
+// SarifSchema the JSON schema for SARIF 2.1.0
+const SarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+// SarifReportMin is a minimum representation of a SARIF 2.1.0 log
+//
+// It contains only the necessary fields for validation and listing.
+// A single log can contain multiple runs, each from a different tool
+type SarifReportMin struct {
+	Schema  string     `json:"$schema,omitempty"`
+	Version string     `json:"version"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []SarifRule `json:"rules,omitempty"`
+}
+
+type SarifRule struct {
+	ID                   string                  `json:"id"`
+	Name                 string                  `json:"name,omitempty"`
+	ShortDescription     *SarifMessage           `json:"shortDescription,omitempty"`
+	HelpURI              string                  `json:"helpUri,omitempty"`
+	DefaultConfiguration *SarifRuleConfiguration `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]any          `json:"properties,omitempty"`
+}
+
+type SarifRuleConfiguration struct {
+	Level string `json:"level,omitempty"`
+}
+
+type SarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	RuleIndex    *int               `json:"ruleIndex,omitempty"`
+	Level        string             `json:"level,omitempty"`
+	Message      SarifMessage       `json:"message"`
+	Locations    []SarifLocation    `json:"locations,omitempty"`
+	Suppressions []SarifSuppression `json:"suppressions,omitempty"`
+	Properties   map[string]any     `json:"properties,omitempty"`
+}
+
+// SarifSuppression marks a result as accepted, suppressed results are still reported
+type SarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region,omitempty"`
+}
+
+type SarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type SarifRegion struct {
+	StartLine int `json:"startLine,omitempty"`
+}
+
+// SarifFinding a result with the values resolved from the rule and run
+type SarifFinding struct {
+	Tool     string
+	RuleID   string
+	Level    string
+	Severity string
+	Message  string
+	// Location the first location as uri:line, "" if the result has no location
+	Location string
+}
+
+// Findings every result from every run with level and severity resolved
+func (r *SarifReportMin) Findings() []SarifFinding {
+	findings := []SarifFinding{}
+	for _, run := range r.Runs {
+		for _, result := range run.Results {
+			findings = append(findings, run.finding(result))
+		}
+	}
+	return findings
+}
+
+func (r *SarifRun) finding(result SarifResult) SarifFinding {
+	rule := r.rule(result)
+	return SarifFinding{
+		Tool:     r.Tool.Driver.Name,
+		RuleID:   result.RuleID,
+		Level:    result.level(rule),
+		Severity: result.severity(rule),
+		Message:  result.Message.Text,
+		Location: result.location(),
+	}
+}
+
+// rule find the rule by index or ID, nil if the tool doesn't define rules
+func (r *SarifRun) rule(result SarifResult) *SarifRule {
+	rules := r.Tool.Driver.Rules
+	if result.RuleIndex != nil && *result.RuleIndex >= 0 && *result.RuleIndex < len(rules) {
+		return &rules[*result.RuleIndex]
+	}
+	for i := range rules {
+		if rules[i].ID == result.RuleID {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// level the result level, then the rule default, SARIF defines "warning" as the default
+func (r *SarifResult) level(rule *SarifRule) string {
+	if r.Level != "" {
+		return strings.ToLower(r.Level)
+	}
+	if rule != nil && rule.DefaultConfiguration != nil && rule.DefaultConfiguration.Level != "" {
+		return strings.ToLower(rule.DefaultConfiguration.Level)
+	}
+	return "warning"
+}
+
+// severity the security-severity score mapped to a CVSS rating, the level is used if there is no score
+//
+// The security-severity property on the result takes precedence over the rule property
+func (r *SarifResult) severity(rule *SarifRule) string {
+	score, ok := securitySeverity(r.Properties)
+	if !ok && rule != nil {
+		score, ok = securitySeverity(rule.Properties)
+	}
+
+	if ok {
+		switch {
+		case score >= 9.0:
+			return "critical"
+		case score >= 7.0:
+			return "high"
+		case score >= 4.0:
+			return "medium"
+		case score > 0:
+			return "low"
+		default:
+			return "none"
+		}
+	}
+
+	switch r.level(rule) {
+	case "error":
+		return "high"
+	case "warning":
+		return "medium"
+	case "note":
+		return "low"
+	default:
+		return "none"
+	}
+}
+
+// location the first location as uri:line, "" if none
+func (r *SarifResult) location() string {
+	if len(r.Locations) == 0 {
+		return ""
+	}
+	physical := r.Locations[0].PhysicalLocation
+	if physical.Region == nil || physical.Region.StartLine == 0 {
+		return physical.ArtifactLocation.URI
+	}
+	return fmt.Sprintf("%s:%d", physical.ArtifactLocation.URI, physical.Region.StartLine)
+}
+
+// LocationShort the location with a long URI clipped, "-" if none
+func (f *SarifFinding) LocationShort() string {
+	if f.Location == "" {
+		return "-"
+	}
+	return format.Summarize(f.Location, 50, format.ClipMiddle)
+}
+
+// securitySeverity tools encode the score as either a string or a number
+func securitySeverity(properties map[string]any) (float64, bool) {
+	value, ok := properties["security-severity"]
+	if !ok {
+		return 0, false
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		score, err := strconv.ParseFloat(v, 64)
+		return score, err == nil
+	}
+	return 0, false
+}
+
+// Finding the result normalized, the source tool is the run's driver name
+func (f *SarifFinding) Finding() Finding {
+	return Finding{
+		ID:         f.RuleID,
+		Severity:   findingSeverity(f.Severity),
+		Location:   f.Location,
+		SourceTool: f.Tool,
+		Raw:        *f,
+	}
+}
+
+// NormalizedFindings one finding for each result in every run
+func (r *SarifReportMin) NormalizedFindings() []Finding {
+	findings := []Finding{}
+	for _, finding := range r.Findings() {
+		findings = append(findings, finding.Finding())
+	}
+	return findings
+}
@@ -7,19 +7,29 @@ import (
 
 type SemgrepReportMin struct {
 	Version string           `json:"version"`
-	Errors  []semgrepError   `json:"errors"`
+	Errors  []SemgrepError   `json:"errors"`
 	Results []SemgrepResults `json:"results"`
 }
 
-type semgrepError struct {
+// SemgrepError a scan error, the type is a string or a list with the name first
+type SemgrepError struct {
+	Code    int    `json:"code"`
 	Level   string `json:"level"`
+	Type    any    `json:"type"`
 	Message string `json:"message"`
 	Path    string `json:"path"`
 }
 
 type SemgrepResults struct {
-	Extra   SemgrepExtra `json:"extra"`
-	CheckID string       `json:"check_id"`
+	Extra   SemgrepExtra    `json:"extra"`
+	CheckID string          `json:"check_id"`
+	Path    string          `json:"path"`
+	Start   SemgrepPosition `json:"start"`
+}
+
+type SemgrepPosition struct {
+	Line int `json:"line"`
+	Col  int `json:"col"`
 }
 
 type SemgrepExtra struct {
@@ -48,7 +58,20 @@ func (s *SemgrepReportMin) SelectBySeverity(severity string) []SemgrepResults {
 	return results
 }
 
-func (s *semgrepError) ShortMessage() string {
+// TypeName the error type without details, for example "PartialParsing", "-" if not set
+func (s *SemgrepError) TypeName() string {
+	switch v := s.Type.(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) > 0 {
+			return fmt.Sprintf("%v", v[0])
+		}
+	}
+	return "-"
+}
+
+func (s *SemgrepError) ShortMessage() string {
 	parts := strings.Split(s.Message, "\n")
 	if len(parts) == 0 {
 		return "-"
@@ -82,3 +105,58 @@ func (s *SemgrepMetadata) OwaspIDs() string {
 		return "-"
 	}
 }
+
+// CWEs the CWE IDs without the title, for example "CWE-89"
+func (s *SemgrepMetadata) CWEs() []string {
+	ids := []string{}
+	for _, cwe := range semgrepStrings(s.CWE) {
+		id, _, _ := strings.Cut(cwe, ":")
+		ids = append(ids, strings.TrimSpace(id))
+	}
+	return ids
+}
+
+// OwaspCategories the OWASP Top 10 IDs without the title, for example "A03:2021"
+func (s *SemgrepMetadata) OwaspCategories() []string {
+	ids := []string{}
+	for _, owasp := range semgrepStrings(s.Owasp) {
+		id, _, _ := strings.Cut(owasp, " - ")
+		ids = append(ids, strings.TrimSpace(id))
+	}
+	return ids
+}
+
+// semgrepStrings metadata fields can be a string or a list of strings
+func semgrepStrings(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := []string{}
+		for _, item := range v {
+			values = append(values, fmt.Sprintf("%v", item))
+		}
+		return values
+	}
+	return []string{}
+}
+
+// Finding the result normalized, the severity is ERROR, WARNING or INFO lowercased
+func (s *SemgrepResults) Finding() Finding {
+	return Finding{
+		ID:         s.CheckID,
+		Severity:   findingSeverity(s.Extra.Severity),
+		Location:   fmt.Sprintf("%s:%d", s.Path, s.Start.Line),
+		SourceTool: "semgrep",
+		Raw:        *s,
+	}
+}
+
+// NormalizedFindings one finding for each result
+func (s *SemgrepReportMin) NormalizedFindings() []Finding {
+	findings := make([]Finding, 0, len(s.Results))
+	for _, result := range s.Results {
+		findings = append(findings, result.Finding())
+	}
+	return findings
+}
@@ -0,0 +1,126 @@
+package artifacts
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gatecheckdev/gatecheck/pkg/format"
+)
+
+// SPDX license values that don't identify a license
+const (
+	SpdxNoAssertion = "NOASSERTION"
+	SpdxNone        = "NONE"
+)
+
+// SpdxReportMin is a minimum representation of an SPDX 2.3 JSON document
+//
+// It contains only the necessary fields for validation and listing
+type SpdxReportMin struct {
+	SPDXVersion   string             `json:"spdxVersion"`
+	SPDXID        string             `json:"SPDXID"`
+	Name          string             `json:"name"`
+	Packages      []SpdxPackage      `json:"packages"`
+	Relationships []SpdxRelationship `json:"relationships"`
+}
+
+type SpdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	ExternalRefs     []SpdxExternalRef `json:"externalRefs"`
+}
+
+type SpdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type SpdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// PURL the locator of the first purl external reference, "" if none
+func (p *SpdxPackage) PURL() string {
+	for _, ref := range p.ExternalRefs {
+		if strings.EqualFold(ref.ReferenceType, "purl") {
+			return ref.ReferenceLocator
+		}
+	}
+	return ""
+}
+
+// PURLType the package type from the purl, for example "npm" in "pkg:npm/lodash@4.17.21"
+func (p *SpdxPackage) PURLType() string {
+	purlType, _, _ := strings.Cut(strings.TrimPrefix(p.PURL(), "pkg:"), "/")
+	return purlType
+}
+
+// Licenses the declared and concluded license expressions, NOASSERTION and NONE are excluded
+func (p *SpdxPackage) Licenses() []string {
+	licenses := []string{}
+	for _, license := range []string{p.LicenseDeclared, p.LicenseConcluded} {
+		if !spdxLicenseAsserted(license) || slices.Contains(licenses, license) {
+			continue
+		}
+		licenses = append(licenses, license)
+	}
+	return licenses
+}
+
+// LicenseIDs every license identifier in the declared and concluded expressions
+//
+// Operators and parentheses are removed, "MIT OR (Apache-2.0 AND BSD-3-Clause)"
+// is three license IDs
+func (p *SpdxPackage) LicenseIDs() []string {
+	ids := []string{}
+	for _, expression := range p.Licenses() {
+		fields := strings.Fields(strings.NewReplacer("(", " ", ")", " ").Replace(expression))
+		for _, field := range fields {
+			switch strings.ToUpper(field) {
+			case "AND", "OR", "WITH":
+				continue
+			}
+			if !slices.Contains(ids, field) {
+				ids = append(ids, field)
+			}
+		}
+	}
+	return ids
+}
+
+// SpdxLicenseShort the license expression, "-" if not asserted
+func SpdxLicenseShort(expression string) string {
+	if !spdxLicenseAsserted(expression) {
+		return "-"
+	}
+	return format.Summarize(expression, 40, format.ClipRight)
+}
+
+// RelationshipsFrom every relationship with the SPDX ID as the element, filtered by type unless ""
+func (r *SpdxReportMin) RelationshipsFrom(spdxID string, relationshipType string) []SpdxRelationship {
+	relationships := []SpdxRelationship{}
+	for _, relationship := range r.Relationships {
+		if relationship.SPDXElementID != spdxID {
+			continue
+		}
+		if relationshipType != "" && !strings.EqualFold(relationship.RelationshipType, relationshipType) {
+			continue
+		}
+		relationships = append(relationships, relationship)
+	}
+	return relationships
+}
+
+func spdxLicenseAsserted(license string) bool {
+	switch strings.TrimSpace(license) {
+	case "", SpdxNoAssertion, SpdxNone:
+		return false
+	}
+	return true
+}
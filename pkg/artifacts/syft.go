@@ -0,0 +1,87 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gatecheckdev/gatecheck/pkg/format"
+)
+
+// SyftReportMin is a minimum representation of an Anchore Syft JSON SBOM
+//
+// It contains only the necessary fields for validation and listing
+type SyftReportMin struct {
+	Descriptor SyftDescriptor `json:"descriptor"`
+	Artifacts  []SyftPackage  `json:"artifacts"`
+}
+
+type SyftDescriptor struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type SyftPackage struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name"`
+	Version   string         `json:"version"`
+	Type      string         `json:"type"`
+	PURL      string         `json:"purl"`
+	Licenses  []SyftLicense  `json:"licenses"`
+	Locations []SyftLocation `json:"locations"`
+}
+
+type SyftLocation struct {
+	Path    string `json:"path"`
+	LayerID string `json:"layerID"`
+}
+
+// SyftLicense a single license for a package
+//
+// Older syft schemas encode licenses as strings, newer schemas use an object
+type SyftLicense struct {
+	Value          string `json:"value"`
+	SPDXExpression string `json:"spdxExpression"`
+	Type           string `json:"type"`
+}
+
+func (l *SyftLicense) UnmarshalJSON(data []byte) error {
+	value := ""
+	if err := json.Unmarshal(data, &value); err == nil {
+		l.Value = value
+		return nil
+	}
+
+	// alias prevents recursive calls to this function
+	type license SyftLicense
+	return json.Unmarshal(data, (*license)(l))
+}
+
+// LicenseNames the SPDX expression for each license, falling back to the value
+func (p *SyftPackage) LicenseNames() []string {
+	names := []string{}
+	for _, license := range p.Licenses {
+		if license.SPDXExpression != "" {
+			names = append(names, license.SPDXExpression)
+			continue
+		}
+		names = append(names, license.Value)
+	}
+	return names
+}
+
+// LicensesShort comma separated license names, "-" if none
+func (p *SyftPackage) LicensesShort() string {
+	names := p.LicenseNames()
+	if len(names) == 0 {
+		return "-"
+	}
+	return format.Summarize(strings.Join(names, ", "), 40, format.ClipRight)
+}
+
+// LocationShort the first location path, "-" if none
+func (p *SyftPackage) LocationShort() string {
+	if len(p.Locations) == 0 {
+		return "-"
+	}
+	return format.Summarize(p.Locations[0].Path, 50, format.ClipMiddle)
+}
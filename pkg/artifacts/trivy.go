@@ -0,0 +1,143 @@
+package artifacts
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// TrivyReportMin is a minimum representation of an Aqua Security Trivy JSON report
+//
+// It contains only the necessary fields for validation and listing
+type TrivyReportMin struct {
+	SchemaVersion int           `json:"SchemaVersion"`
+	ArtifactName  string        `json:"ArtifactName"`
+	ArtifactType  string        `json:"ArtifactType"`
+	Results       []TrivyResult `json:"Results"`
+}
+
+type TrivyResult struct {
+	Target          string               `json:"Target"`
+	Class           string               `json:"Class"`
+	Type            string               `json:"Type"`
+	Vulnerabilities []TrivyVulnerability `json:"Vulnerabilities"`
+}
+
+type TrivyVulnerability struct {
+	VulnerabilityID  string `json:"VulnerabilityID"`
+	PkgName          string `json:"PkgName"`
+	InstalledVersion string `json:"InstalledVersion"`
+	FixedVersion     string `json:"FixedVersion"`
+	Status           string `json:"Status"`
+	Severity         string `json:"Severity"`
+	PrimaryURL       string `json:"PrimaryURL"`
+	// PkgIdentifier only set by newer versions of trivy
+	PkgIdentifier TrivyPkgIdentifier `json:"PkgIdentifier"`
+	// CVSS keyed by source, for example nvd or redhat
+	CVSS map[string]TrivyCVSS `json:"CVSS"`
+}
+
+type TrivyPkgIdentifier struct {
+	PURL string `json:"PURL"`
+}
+
+type TrivyCVSS struct {
+	V2Vector  string  `json:"V2Vector"`
+	V3Vector  string  `json:"V3Vector"`
+	V40Vector string  `json:"V40Vector"`
+	V2Score   float64 `json:"V2Score"`
+	V3Score   float64 `json:"V3Score"`
+	V40Score  float64 `json:"V40Score"`
+}
+
+// AllVulnerabilities the vulnerabilities from every result target in a single slice
+func (r *TrivyReportMin) AllVulnerabilities() []TrivyVulnerability {
+	vulnerabilities := []TrivyVulnerability{}
+	for _, result := range r.Results {
+		vulnerabilities = append(vulnerabilities, result.Vulnerabilities...)
+	}
+	return vulnerabilities
+}
+
+// FixState the trivy status normalized to fixed, not-fixed, wont-fix or unknown
+//
+// Older reports don't have a status, a fixed version means it is fixed
+func (v *TrivyVulnerability) FixState() string {
+	switch strings.ToLower(strings.TrimSpace(v.Status)) {
+	case "fixed":
+		return FixStateFixed
+	case "affected", "fix_deferred":
+		return FixStateNotFixed
+	case "will_not_fix", "end_of_life":
+		return FixStateWontFix
+	case "":
+		if v.FixedVersion != "" {
+			return FixStateFixed
+		}
+		return FixStateNotFixed
+	}
+	return FixStateUnknown
+}
+
+// FixedVersions the comma separated fixed versions as a slice, empty if there is no fix
+func (v *TrivyVulnerability) FixedVersions() []string {
+	versions := []string{}
+	for _, version := range strings.Split(v.FixedVersion, ",") {
+		if version = strings.TrimSpace(version); version != "" {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// CVSSScores every version with a score from every source, ordered by source
+func (v *TrivyVulnerability) CVSSScores() []CVSS {
+	scores := []CVSS{}
+	for source, item := range v.CVSS {
+		if item.V2Score > 0 {
+			scores = append(scores, CVSS{Version: "2.0", Score: item.V2Score, Vector: item.V2Vector, Source: source})
+		}
+		if item.V3Score > 0 {
+			version := cvssVersionFromVector(item.V3Vector)
+			if version == "" {
+				version = "3.1"
+			}
+			scores = append(scores, CVSS{Version: version, Score: item.V3Score, Vector: item.V3Vector, Source: source})
+		}
+		if item.V40Score > 0 {
+			scores = append(scores, CVSS{Version: "4.0", Score: item.V40Score, Vector: item.V40Vector, Source: source})
+		}
+	}
+	slices.SortStableFunc(scores, func(a, b CVSS) int {
+		return cmp.Compare(a.Source, b.Source)
+	})
+	return scores
+}
+
+// NormalizedFindings one finding for each vulnerability in each result target
+//
+// The package type is the result type, for example "debian" or "npm", and the location is the target
+func (r *TrivyReportMin) NormalizedFindings() []Finding {
+	findings := []Finding{}
+	for _, result := range r.Results {
+		for _, vulnerability := range result.Vulnerabilities {
+			findings = append(findings, Finding{
+				ID:       vulnerability.VulnerabilityID,
+				Severity: findingSeverity(vulnerability.Severity),
+				Packages: []FindingPackage{{
+					Name:    vulnerability.PkgName,
+					Version: vulnerability.InstalledVersion,
+					Purl:    vulnerability.PkgIdentifier.PURL,
+					Type:    result.Type,
+				}},
+				Location:      result.Target,
+				SourceTool:    "trivy",
+				FixState:      vulnerability.FixState(),
+				FixedVersions: vulnerability.FixedVersions(),
+				CVSS:          vulnerability.CVSSScores(),
+				Raw:           vulnerability,
+			})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,126 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// TrufflehogReportMin is a minimum representation of the TruffleHog v3 --json output
+//
+// TruffleHog writes one JSON object per line for each result
+type TrufflehogReportMin []TrufflehogFinding
+
+type TrufflehogFinding struct {
+	SourceName     string                   `json:"SourceName"`
+	SourceMetadata TrufflehogSourceMetadata `json:"SourceMetadata"`
+	DetectorName   string                   `json:"DetectorName"`
+	DecoderName    string                   `json:"DecoderName"`
+	Verified       bool                     `json:"Verified"`
+	Redacted       string                   `json:"Redacted"`
+}
+
+// TrufflehogSourceMetadata the data is keyed by source, for example Git, Github or Filesystem
+type TrufflehogSourceMetadata struct {
+	Data map[string]TrufflehogSourceData `json:"Data"`
+}
+
+// TrufflehogSourceData the fields shared by the git and filesystem sources
+type TrufflehogSourceData struct {
+	Commit     string `json:"commit"`
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Repository string `json:"repository"`
+	Link       string `json:"link"`
+}
+
+// DecodeTrufflehog decode every result in the stream until EOF
+//
+// Log messages are skipped if stderr was redirected into the report
+func DecodeTrufflehog(r io.Reader, report *TrufflehogReportMin) error {
+	decoder := json.NewDecoder(r)
+	for {
+		finding := TrufflehogFinding{}
+		err := decoder.Decode(&finding)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if finding.DetectorName == "" {
+			continue
+		}
+		*report = append(*report, finding)
+	}
+}
+
+// Source the first source in the metadata, empty if there is no metadata
+func (f *TrufflehogFinding) Source() TrufflehogSourceData {
+	for _, data := range f.SourceMetadata.Data {
+		return data
+	}
+	return TrufflehogSourceData{}
+}
+
+// LocationShort file:line, "-" if the source has no file
+func (f *TrufflehogFinding) LocationShort() string {
+	source := f.Source()
+	if source.File == "" {
+		return "-"
+	}
+	if source.Line == 0 {
+		return source.File
+	}
+	return fmt.Sprintf("%s:%d", source.File, source.Line)
+}
+
+// CommitShort the first 8 characters of the commit, "-" if the source has no commit
+func (f *TrufflehogFinding) CommitShort() string {
+	commit := f.Source().Commit
+	if commit == "" {
+		return "-"
+	}
+	return commit[:min(8, len(commit))]
+}
+
+func (r *TrufflehogReportMin) SelectByVerified(verified bool) []TrufflehogFinding {
+	findings := []TrufflehogFinding{}
+	for _, finding := range *r {
+		if finding.Verified == verified {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// SelectUnverifiedByDetector case insensitive detector name match
+func (r *TrufflehogReportMin) SelectUnverifiedByDetector(detector string) []TrufflehogFinding {
+	return slices.DeleteFunc(r.SelectByVerified(false), func(finding TrufflehogFinding) bool {
+		return !strings.EqualFold(finding.DetectorName, detector)
+	})
+}
+
+// NormalizedFindings one finding for each result, the ID is the detector name
+//
+// TruffleHog doesn't rate secrets so the severity is unknown
+func (r *TrufflehogReportMin) NormalizedFindings() []Finding {
+	findings := make([]Finding, 0, len(*r))
+	for _, finding := range *r {
+		location := ""
+		if finding.Source().File != "" {
+			location = finding.LocationShort()
+		}
+		findings = append(findings, Finding{
+			ID:         finding.DetectorName,
+			Severity:   FindingSeverityUnknown,
+			Location:   location,
+			SourceTool: "trufflehog",
+			Raw:        finding,
+		})
+	}
+	return findings
+}
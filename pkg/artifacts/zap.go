@@ -0,0 +1,114 @@
+package artifacts
+
+import (
+	"strings"
+)
+
+// ZapReportMin is a minimum representation of an OWASP ZAP traditional JSON report
+//
+// It contains only the necessary fields for validation and listing.
+// ZAP encodes every number as a string
+type ZapReportMin struct {
+	ProgramName string    `json:"@programName"`
+	Version     string    `json:"@version"`
+	Sites       []ZapSite `json:"site"`
+}
+
+type ZapSite struct {
+	Name   string     `json:"@name"`
+	Host   string     `json:"@host"`
+	Alerts []ZapAlert `json:"alerts"`
+}
+
+type ZapAlert struct {
+	PluginID   string        `json:"pluginid"`
+	AlertRef   string        `json:"alertRef"`
+	Alert      string        `json:"alert"`
+	Name       string        `json:"name"`
+	RiskCode   string        `json:"riskcode"`
+	Confidence string        `json:"confidence"`
+	RiskDesc   string        `json:"riskdesc"`
+	CWEID      string        `json:"cweid"`
+	WASCID     string        `json:"wascid"`
+	Count      string        `json:"count"`
+	Instances  []ZapInstance `json:"instances"`
+}
+
+type ZapInstance struct {
+	URI    string `json:"uri"`
+	Method string `json:"method"`
+	Param  string `json:"param"`
+}
+
+// Risk the risk code as a level: high, medium, low or informational
+func (a *ZapAlert) Risk() string {
+	switch strings.TrimSpace(a.RiskCode) {
+	case "3":
+		return "high"
+	case "2":
+		return "medium"
+	case "1":
+		return "low"
+	case "0":
+		return "informational"
+	}
+	return "unknown"
+}
+
+// ConfidenceLevel the confidence code as a level: false positive, low, medium, high or confirmed
+func (a *ZapAlert) ConfidenceLevel() string {
+	switch strings.TrimSpace(a.Confidence) {
+	case "4":
+		return "confirmed"
+	case "3":
+		return "high"
+	case "2":
+		return "medium"
+	case "1":
+		return "low"
+	case "0":
+		return "false positive"
+	}
+	return "unknown"
+}
+
+// URIShort the first instance URI, "-" if none
+func (a *ZapAlert) URIShort() string {
+	if len(a.Instances) == 0 {
+		return "-"
+	}
+	return a.Instances[0].URI
+}
+
+// AllAlerts the alerts from every site in a single slice
+func (r *ZapReportMin) AllAlerts() []ZapAlert {
+	alerts := []ZapAlert{}
+	for _, site := range r.Sites {
+		alerts = append(alerts, site.Alerts...)
+	}
+	return alerts
+}
+
+// Finding the alert normalized, the ID is the plugin ID and the severity is the risk
+func (a *ZapAlert) Finding() Finding {
+	location := ""
+	if len(a.Instances) > 0 {
+		location = a.Instances[0].URI
+	}
+	return Finding{
+		ID:         a.PluginID,
+		Severity:   a.Risk(),
+		Location:   location,
+		SourceTool: "zap",
+		Raw:        *a,
+	}
+}
+
+// NormalizedFindings one finding for each alert on every site
+func (r *ZapReportMin) NormalizedFindings() []Finding {
+	findings := []Finding{}
+	for _, alert := range r.AllAlerts() {
+		findings = append(findings, alert.Finding())
+	}
+	return findings
+}
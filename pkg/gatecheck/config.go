@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 
+	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
 	"github.com/olekukonko/tablewriter"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/spf13/viper"
@@ -22,12 +23,21 @@ import (
 // Metadata fields are intended for arbitrary data and shouldn't
 // conflict with rule validation
 type Config struct {
-	Version   string               `json:"version"   toml:"version"   yaml:"version"`
-	Metadata  configMetadata       `json:"metadata"  toml:"metadata"  yaml:"metadata"`
-	Grype     reportWithCVEs       `json:"grype"     toml:"grype"     yaml:"grype"`
-	Cyclonedx reportWithCVEs       `json:"cyclonedx" toml:"cyclonedx" yaml:"cyclonedx"`
-	Semgrep   configSemgrepReport  `json:"semgrep"   toml:"semgrep"   yaml:"semgrep"`
-	Gitleaks  configGitleaksReport `json:"gitleaks"  toml:"gitleaks"  yaml:"gitleaks"`
+	Version     string                  `json:"version"     toml:"version"     yaml:"version"`
+	Metadata    configMetadata          `json:"metadata"    toml:"metadata"    yaml:"metadata"`
+	Grype       ReportWithCVEs          `json:"grype"       toml:"grype"       yaml:"grype"`
+	Cyclonedx   configCyclonedx         `json:"cyclonedx"   toml:"cyclonedx"   yaml:"cyclonedx"`
+	Semgrep     configSemgrepReport     `json:"semgrep"     toml:"semgrep"     yaml:"semgrep"`
+	Gitleaks    configGitleaksReport    `json:"gitleaks"    toml:"gitleaks"    yaml:"gitleaks"`
+	Syft        configSyftReport        `json:"syft"        toml:"syft"        yaml:"syft"`
+	Trivy       ReportWithCVEs          `json:"trivy"       toml:"trivy"       yaml:"trivy"`
+	Sarif       configSarifReport       `json:"sarif"       toml:"sarif"       yaml:"sarif"`
+	Spdx        configSpdxReport        `json:"spdx"        toml:"spdx"        yaml:"spdx"`
+	Osv         ReportWithCVEs          `json:"osv"         toml:"osv"         yaml:"osv"`
+	Govulncheck configGovulncheckReport `json:"govulncheck" toml:"govulncheck" yaml:"govulncheck"`
+	Zap         configZapReport         `json:"zap"         toml:"zap"         yaml:"zap"`
+	Iac         configIacReport         `json:"iac"         toml:"iac"         yaml:"iac"`
+	Trufflehog  configTrufflehogReport  `json:"trufflehog"  toml:"trufflehog"  yaml:"trufflehog"`
 }
 
 func (c *Config) String() string {
@@ -49,12 +59,178 @@ func (c *Config) String() string {
 }
 
 type configGitleaksReport struct {
-	LimitEnabled bool `json:"limitEnabled" toml:"limitEnabled" yaml:"limitEnabled"`
+	LimitEnabled bool                    `json:"limitEnabled" toml:"limitEnabled" yaml:"limitEnabled"`
+	Allowlist    configGitleaksAllowlist `json:"allowlist"    toml:"allowlist"    yaml:"allowlist"`
+}
+
+type configGitleaksAllowlist struct {
+	Enabled bool                  `json:"enabled" toml:"enabled" yaml:"enabled"`
+	Entries []configGitleaksAllow `json:"entries" toml:"entries" yaml:"entries"`
+}
+
+// configGitleaksAllow a finding is allowed if it matches every field that is set
+//
+// File is a glob, "**" matches any number of directories.
+// ExpiresAt is a date (2006-01-02) or an RFC 3339 timestamp, empty never expires
+type configGitleaksAllow struct {
+	Fingerprint string `json:"fingerprint" toml:"fingerprint" yaml:"fingerprint"`
+	RuleID      string `json:"ruleId"      toml:"ruleId"      yaml:"ruleId"`
+	File        string `json:"file"        toml:"file"        yaml:"file"`
+	Reason      string `json:"reason"      toml:"reason"      yaml:"reason"`
+	ExpiresAt   string `json:"expiresAt"   toml:"expiresAt"   yaml:"expiresAt"`
+}
+
+// configTrufflehogReport verified secrets and unverified secrets are limited separately
+type configTrufflehogReport struct {
+	VerifiedLimit   configLimit         `json:"verifiedLimit"   toml:"verifiedLimit"   yaml:"verifiedLimit"`
+	UnverifiedLimit configDetectorLimit `json:"unverifiedLimit" toml:"unverifiedLimit" yaml:"unverifiedLimit"`
+}
+
+// configDetectorLimit limits for each detector, detectors not in the list are not limited
+type configDetectorLimit struct {
+	Enabled   bool             `json:"enabled"   toml:"enabled"   yaml:"enabled"`
+	Detectors []configDetector `json:"detectors" toml:"detectors" yaml:"detectors"`
+}
+
+// configDetector a TruffleHog detector matched by name, case insensitive
+type configDetector struct {
+	Name  string `json:"name"  toml:"name"  yaml:"name"`
+	Limit uint   `json:"limit" toml:"limit" yaml:"limit"`
+}
+
+type configSyftReport struct {
+	PackageLimit     configPackageLimit     `json:"packageLimit"     toml:"packageLimit"     yaml:"packageLimit"`
+	RequiredMetadata configRequiredMetadata `json:"requiredMetadata" toml:"requiredMetadata" yaml:"requiredMetadata"`
+}
+
+type configPackageLimit struct {
+	Enabled  bool            `json:"enabled"  toml:"enabled"  yaml:"enabled"`
+	Packages []configPackage `json:"packages" toml:"packages" yaml:"packages"`
+}
+
+// configPackage a package matched by name, version and type
+//
+// Empty version or type will match any value
+type configPackage struct {
+	Name     string `json:"name"    toml:"name"    yaml:"name"`
+	Version  string `json:"version" toml:"version" yaml:"version"`
+	Type     string `json:"type"    toml:"type"    yaml:"type"`
+	Metadata struct {
+		Tags []string `json:"tags" toml:"tags" yaml:"tags"`
+	}
+}
+
+type configRequiredMetadata struct {
+	Enabled bool `json:"enabled" toml:"enabled" yaml:"enabled"`
+	Version bool `json:"version" toml:"version" yaml:"version"`
+	PURL    bool `json:"purl"    toml:"purl"    yaml:"purl"`
+	License bool `json:"license" toml:"license" yaml:"license"`
+}
+
+type configSpdxReport struct {
+	PackageLimit     configPackageLimit     `json:"packageLimit"     toml:"packageLimit"     yaml:"packageLimit"`
+	LicenseLimit     configLicenseLimit     `json:"licenseLimit"     toml:"licenseLimit"     yaml:"licenseLimit"`
+	RequiredMetadata configRequiredMetadata `json:"requiredMetadata" toml:"requiredMetadata" yaml:"requiredMetadata"`
+}
+
+// configLicenseLimit SPDX license identifiers, case insensitive
+type configLicenseLimit struct {
+	Enabled  bool     `json:"enabled"  toml:"enabled"  yaml:"enabled"`
+	Licenses []string `json:"licenses" toml:"licenses" yaml:"licenses"`
+}
+
+// configGovulncheckReport rules only apply to vulnerabilities with a trace to a called function
+//
+// Imported and required vulnerabilities are informational. IDs match the OSV ID or any alias
+type configGovulncheckReport struct {
+	CalledLimit       configLimit             `json:"calledLimit"       toml:"calledLimit"       yaml:"calledLimit"`
+	CVELimit          configCVELimit          `json:"cveLimit"          toml:"cveLimit"          yaml:"cveLimit"`
+	CVERiskAcceptance configCVERiskAcceptance `json:"cveRiskAcceptance" toml:"cveRiskAcceptance" yaml:"cveRiskAcceptance"`
+}
+
+type configSarifReport struct {
+	LevelLimit           configSarifLevelLimit `json:"levelLimit"           toml:"levelLimit"           yaml:"levelLimit"`
+	SeverityLimit        configServerityLimit  `json:"severityLimit"        toml:"severityLimit"        yaml:"severityLimit"`
+	RuleIDLimit          configRuleIDList      `json:"ruleIdLimit"          toml:"ruleIdLimit"          yaml:"ruleIdLimit"`
+	RuleIDRiskAcceptance configRuleIDList      `json:"ruleIdRiskAcceptance" toml:"ruleIdRiskAcceptance" yaml:"ruleIdRiskAcceptance"`
+}
+
+type configSarifLevelLimit struct {
+	Error   configLimit `json:"error"   toml:"error"   yaml:"error"`
+	Warning configLimit `json:"warning" toml:"warning" yaml:"warning"`
+	Note    configLimit `json:"note"    toml:"note"    yaml:"note"`
+}
+
+type configRuleIDList struct {
+	Enabled bool           `json:"enabled" toml:"enabled" yaml:"enabled"`
+	RuleIDs []configRuleID `json:"ruleIds" toml:"ruleIds" yaml:"ruleIds"`
+}
+
+type configRuleID struct {
+	ID       string `json:"id" toml:"id" yaml:"id"`
+	Metadata struct {
+		Tags []string `json:"tags" toml:"tags" yaml:"tags"`
+	}
 }
 
 type configSemgrepReport struct {
 	SeverityLimit        configSemgrepSeverityLimit        `json:"severityLimit"        toml:"severityLimit"        yaml:"severityLimit"`
 	ImpactRiskAcceptance configSemgrepImpactRiskAcceptance `json:"impactRiskAcceptance" toml:"impactRiskAcceptance" yaml:"impactRiskAcceptance"`
+	RuleIDLimit          configRuleIDList                  `json:"ruleIdLimit"          toml:"ruleIdLimit"          yaml:"ruleIdLimit"`
+	RuleIDRiskAcceptance configRuleIDList                  `json:"ruleIdRiskAcceptance" toml:"ruleIdRiskAcceptance" yaml:"ruleIdRiskAcceptance"`
+	CWELimit             configIDList                      `json:"cweLimit"             toml:"cweLimit"             yaml:"cweLimit"`
+	CWERiskAcceptance    configIDList                      `json:"cweRiskAcceptance"    toml:"cweRiskAcceptance"    yaml:"cweRiskAcceptance"`
+	OwaspLimit           configIDList                      `json:"owaspLimit"           toml:"owaspLimit"           yaml:"owaspLimit"`
+	OwaspRiskAcceptance  configIDList                      `json:"owaspRiskAcceptance"  toml:"owaspRiskAcceptance"  yaml:"owaspRiskAcceptance"`
+	ErrorPolicy          configSemgrepErrorPolicy          `json:"errorPolicy"          toml:"errorPolicy"          yaml:"errorPolicy"`
+	RiskMatrix           configSemgrepRiskMatrix           `json:"riskMatrix"           toml:"riskMatrix"           yaml:"riskMatrix"`
+}
+
+// configSemgrepRiskMatrix the computed risk is the product of the impact, likelihood and confidence weights
+//
+// The risk level is the highest threshold the score meets, scores below the medium threshold are low.
+// Results missing any of the three metadata fields have an unknown risk
+type configSemgrepRiskMatrix struct {
+	Enabled        bool                        `json:"enabled"        toml:"enabled"        yaml:"enabled"`
+	Weights        configSemgrepRiskWeights    `json:"weights"        toml:"weights"        yaml:"weights"`
+	Thresholds     configSemgrepRiskThresholds `json:"thresholds"     toml:"thresholds"     yaml:"thresholds"`
+	RiskLimit      configServerityLimit        `json:"riskLimit"      toml:"riskLimit"      yaml:"riskLimit"`
+	RiskAcceptance configSemgrepRiskAcceptance `json:"riskAcceptance" toml:"riskAcceptance" yaml:"riskAcceptance"`
+}
+
+type configSemgrepRiskWeights struct {
+	High   uint `json:"high"   toml:"high"   yaml:"high"`
+	Medium uint `json:"medium" toml:"medium" yaml:"medium"`
+	Low    uint `json:"low"    toml:"low"    yaml:"low"`
+}
+
+type configSemgrepRiskThresholds struct {
+	Critical uint `json:"critical" toml:"critical" yaml:"critical"`
+	High     uint `json:"high"     toml:"high"     yaml:"high"`
+	Medium   uint `json:"medium"   toml:"medium"   yaml:"medium"`
+}
+
+type configSemgrepRiskAcceptance struct {
+	Enabled bool `json:"enabled" toml:"enabled" yaml:"enabled"`
+	High    bool `json:"high"    toml:"high"    yaml:"high"`
+	Medium  bool `json:"medium"  toml:"medium"  yaml:"medium"`
+	Low     bool `json:"low"     toml:"low"     yaml:"low"`
+}
+
+// configSemgrepErrorPolicy scan errors on ignored paths are not counted or failed
+//
+// Levels and types are case insensitive, ignored paths are globs where "**" matches any number of directories
+type configSemgrepErrorPolicy struct {
+	ErrorLimit  configLimit `json:"errorLimit"  toml:"errorLimit"  yaml:"errorLimit"`
+	FailLevels  []string    `json:"failLevels"  toml:"failLevels"  yaml:"failLevels"`
+	FailTypes   []string    `json:"failTypes"   toml:"failTypes"   yaml:"failTypes"`
+	IgnorePaths []string    `json:"ignorePaths" toml:"ignorePaths" yaml:"ignorePaths"`
+}
+
+// configIDList case insensitive IDs, for example "CWE-89" or "A03:2021"
+type configIDList struct {
+	Enabled bool     `json:"enabled" toml:"enabled" yaml:"enabled"`
+	IDs     []string `json:"ids"     toml:"ids"     yaml:"ids"`
 }
 
 type configSemgrepSeverityLimit struct {
@@ -70,22 +246,152 @@ type configSemgrepImpactRiskAcceptance struct {
 	Low     bool `json:"low"     toml:"low"     yaml:"low"`
 }
 
+type configZapReport struct {
+	RiskLimit                configZapRiskLimit                `json:"riskLimit"                toml:"riskLimit"                yaml:"riskLimit"`
+	PluginIDLimit            configPluginIDList                `json:"pluginIdLimit"            toml:"pluginIdLimit"            yaml:"pluginIdLimit"`
+	PluginIDRiskAcceptance   configPluginIDList                `json:"pluginIdRiskAcceptance"   toml:"pluginIdRiskAcceptance"   yaml:"pluginIdRiskAcceptance"`
+	ConfidenceRiskAcceptance configZapConfidenceRiskAcceptance `json:"confidenceRiskAcceptance" toml:"confidenceRiskAcceptance" yaml:"confidenceRiskAcceptance"`
+}
+
+type configZapRiskLimit struct {
+	High          configLimit `json:"high"          toml:"high"          yaml:"high"`
+	Medium        configLimit `json:"medium"        toml:"medium"        yaml:"medium"`
+	Low           configLimit `json:"low"           toml:"low"           yaml:"low"`
+	Informational configLimit `json:"informational" toml:"informational" yaml:"informational"`
+}
+
+type configPluginIDList struct {
+	Enabled   bool             `json:"enabled"   toml:"enabled"   yaml:"enabled"`
+	PluginIDs []configPluginID `json:"pluginIds" toml:"pluginIds" yaml:"pluginIds"`
+}
+
+type configPluginID struct {
+	ID       string `json:"id" toml:"id" yaml:"id"`
+	Metadata struct {
+		Tags []string `json:"tags" toml:"tags" yaml:"tags"`
+	}
+}
+
+// configZapConfidenceRiskAcceptance alerts at an enabled confidence are risk accepted
+type configZapConfidenceRiskAcceptance struct {
+	Enabled       bool `json:"enabled"       toml:"enabled"       yaml:"enabled"`
+	FalsePositive bool `json:"falsePositive" toml:"falsePositive" yaml:"falsePositive"`
+	Low           bool `json:"low"           toml:"low"           yaml:"low"`
+	Medium        bool `json:"medium"        toml:"medium"        yaml:"medium"`
+}
+
+// configIacReport rules for Checkov and tfsec reports
+type configIacReport struct {
+	SeverityLimit         configServerityLimit `json:"severityLimit"         toml:"severityLimit"         yaml:"severityLimit"`
+	CheckIDLimit          configCheckIDList    `json:"checkIdLimit"          toml:"checkIdLimit"          yaml:"checkIdLimit"`
+	CheckIDRiskAcceptance configCheckIDList    `json:"checkIdRiskAcceptance" toml:"checkIdRiskAcceptance" yaml:"checkIdRiskAcceptance"`
+}
+
+type configCheckIDList struct {
+	Enabled  bool            `json:"enabled"  toml:"enabled"  yaml:"enabled"`
+	CheckIDs []configCheckID `json:"checkIds" toml:"checkIds" yaml:"checkIds"`
+}
+
+// configCheckID a check matched by ID, scoped to resource addresses matching a glob
+//
+// An empty resource will match any resource
+type configCheckID struct {
+	ID       string `json:"id"       toml:"id"       yaml:"id"`
+	Resource string `json:"resource" toml:"resource" yaml:"resource"`
+	Metadata struct {
+		Tags []string `json:"tags" toml:"tags" yaml:"tags"`
+	}
+}
+
 type configMetadata struct {
 	Tags []string `json:"tags" toml:"tags" yaml:"tags"`
 }
 
-type reportWithCVEs struct {
-	SeverityLimit      configServerityLimit     `json:"severityLimit"      toml:"severityLimit"      yaml:"severityLimit"`
-	EPSSLimit          configEPSSLimit          `json:"epssLimit"          toml:"epssLimit"          yaml:"epssLimit"`
-	KEVLimitEnabled    bool                     `json:"kevLimitEnabled"    toml:"kevLimitEnabled"    yaml:"kevLimitEnabled"`
-	CVELimit           configCVELimit           `json:"cveLimit"           toml:"cveLimit"           yaml:"cveLimit"`
-	EPSSRiskAcceptance configEPSSRiskAcceptance `json:"epssRiskAcceptance" toml:"epssRiskAcceptance" yaml:"epssRiskAcceptance"`
-	CVERiskAcceptance  configCVERiskAcceptance  `json:"cveRiskAcceptance"  toml:"cveRiskAcceptance"  yaml:"cveRiskAcceptance"`
+// ReportWithCVEs the config section shared by Grype, CycloneDX, Trivy and OSV-Scanner
+//
+// Exported so configCyclonedx can embed it, TOML encoding skips embedded unexported types
+type ReportWithCVEs struct {
+	SeverityLimit          configCVESeverityLimit       `json:"severityLimit"          toml:"severityLimit"          yaml:"severityLimit"`
+	EPSSLimit              configEPSSLimit              `json:"epssLimit"              toml:"epssLimit"              yaml:"epssLimit"`
+	CVSSLimit              configCVSSLimit              `json:"cvssLimit"              toml:"cvssLimit"              yaml:"cvssLimit"`
+	KEVLimitEnabled        bool                         `json:"kevLimitEnabled"        toml:"kevLimitEnabled"        yaml:"kevLimitEnabled"`
+	KEVLimit               configKEVLimit               `json:"kevLimit"               toml:"kevLimit"               yaml:"kevLimit"`
+	CVELimit               configCVELimit               `json:"cveLimit"               toml:"cveLimit"               yaml:"cveLimit"`
+	EPSSRiskAcceptance     configEPSSRiskAcceptance     `json:"epssRiskAcceptance"     toml:"epssRiskAcceptance"     yaml:"epssRiskAcceptance"`
+	CVERiskAcceptance      configCVERiskAcceptance      `json:"cveRiskAcceptance"      toml:"cveRiskAcceptance"      yaml:"cveRiskAcceptance"`
+	FixStateRiskAcceptance configFixStateRiskAcceptance `json:"fixStateRiskAcceptance" toml:"fixStateRiskAcceptance" yaml:"fixStateRiskAcceptance"`
+}
+
+// configCyclonedx the CVE rules plus the rating preference, other CVE reports have one severity per vulnerability
+type configCyclonedx struct {
+	ReportWithCVEs   `yaml:",inline"`
+	RatingPreference configRatingPreference `json:"ratingPreference" toml:"ratingPreference" yaml:"ratingPreference"`
+}
+
+// configRatingPreference the rating used for severity when a vulnerability has more than one
+//
+// Sources are rating source names in order of preference, for example NVD or GHSA.
+// Fallback is highest, first or a source name, used when none of the sources rated the vulnerability
+type configRatingPreference struct {
+	Sources  []string `json:"sources"  toml:"sources"  yaml:"sources"`
+	Fallback string   `json:"fallback" toml:"fallback" yaml:"fallback"`
+}
+
+func (p configRatingPreference) cyclonedx() artifacts.CyclonedxRatingPreference {
+	return artifacts.CyclonedxRatingPreference{Sources: p.Sources, Fallback: p.Fallback}
+}
+
+// checkConfig values decoding can't reject, returns validate.ErrConfig
+func checkConfig(config *Config) error {
+	if config == nil {
+		return nil
+	}
+	kevLimits := []struct {
+		key   string
+		limit configKEVLimit
+	}{
+		{key: "grype.kevLimit", limit: config.Grype.KEVLimit},
+		{key: "cyclonedx.kevLimit", limit: config.Cyclonedx.KEVLimit},
+		{key: "trivy.kevLimit", limit: config.Trivy.KEVLimit},
+		{key: "osv.kevLimit", limit: config.Osv.KEVLimit},
+	}
+	for _, kevLimit := range kevLimits {
+		if err := kevLimit.limit.check(); err != nil {
+			return fmt.Errorf("%s: %w", kevLimit.key, err)
+		}
+	}
+	return nil
+}
+
+// configKEVLimit when kevLimitEnabled fails on a vulnerability in the KEV catalog
+//
+// Mode is immediate, dueDate to fail after the catalog due date or gracePeriod to fail
+// gracePeriodDays after the vulnerability was added to the catalog.
+// Known ransomware campaign use always fails immediately
+type configKEVLimit struct {
+	Mode            string `json:"mode"            toml:"mode"            yaml:"mode"`
+	GracePeriodDays uint   `json:"gracePeriodDays" toml:"gracePeriodDays" yaml:"gracePeriodDays"`
+}
+
+// configCVESeverityLimit onlyFixable counts only vulnerabilities with a fix available
+type configCVESeverityLimit struct {
+	OnlyFixable bool        `json:"onlyFixable" toml:"onlyFixable" yaml:"onlyFixable"`
+	Critical    configLimit `json:"critical"    toml:"critical"    yaml:"critical"`
+	High        configLimit `json:"high"        toml:"high"        yaml:"high"`
+	Medium      configLimit `json:"medium"      toml:"medium"      yaml:"medium"`
+	Low         configLimit `json:"low"         toml:"low"         yaml:"low"`
+}
+
+// configFixStateRiskAcceptance states are not-fixed, wont-fix or unknown
+type configFixStateRiskAcceptance struct {
+	Enabled bool     `json:"enabled" toml:"enabled" yaml:"enabled"`
+	States  []string `json:"states"  toml:"states"  yaml:"states"`
 }
 
 type configEPSSRiskAcceptance struct {
-	Enabled bool    `json:"enabled" toml:"enabled" yaml:"enabled"`
-	Score   float64 `json:"score"   toml:"score"   yaml:"score"`
+	Enabled    bool    `json:"enabled"    toml:"enabled"    yaml:"enabled"`
+	Score      float64 `json:"score"      toml:"score"      yaml:"score"`
+	Percentile float64 `json:"percentile" toml:"percentile" yaml:"percentile"`
 }
 type configCVERiskAcceptance struct {
 	Enabled bool        `json:"enabled" toml:"enabled" yaml:"enabled"`
@@ -99,8 +405,17 @@ type configServerityLimit struct {
 }
 
 type configEPSSLimit struct {
-	Enabled bool    `json:"enabled" toml:"enabled" yaml:"enabled"`
-	Score   float64 `json:"score"   toml:"score"   yaml:"score"`
+	Enabled    bool    `json:"enabled"    toml:"enabled"    yaml:"enabled"`
+	Score      float64 `json:"score"      toml:"score"      yaml:"score"`
+	Percentile float64 `json:"percentile" toml:"percentile" yaml:"percentile"`
+}
+
+// configCVSSLimit versions is the preference order when a vulnerability has more than one CVSS version,
+// versions that aren't listed are used newest first if none of the listed versions have a score
+type configCVSSLimit struct {
+	Enabled  bool     `json:"enabled"  toml:"enabled"  yaml:"enabled"`
+	Score    float64  `json:"score"    toml:"score"    yaml:"score"`
+	Versions []string `json:"versions" toml:"versions" yaml:"versions"`
 }
 
 type configCVELimit struct {
@@ -108,9 +423,22 @@ type configCVELimit struct {
 	CVEs    []configCVE `json:"cves"    toml:"cves"    yaml:"cves"`
 }
 
+// configCVE expiresAt, reason, owner and ticket document a risk acceptance,
+// an expired acceptance no longer removes the vulnerability
+//
+// package, purl, versionRange and artifactType scope a risk acceptance to matching packages,
+// every field that is set must match
 type configCVE struct {
-	ID       string `json:"id" toml:"id" yaml:"id"`
-	Metadata struct {
+	ID           string `json:"id"           toml:"id"           yaml:"id"`
+	Package      string `json:"package"      toml:"package"      yaml:"package"`
+	Purl         string `json:"purl"         toml:"purl"         yaml:"purl"`
+	VersionRange string `json:"versionRange" toml:"versionRange" yaml:"versionRange"`
+	ArtifactType string `json:"artifactType" toml:"artifactType" yaml:"artifactType"`
+	ExpiresAt    string `json:"expiresAt"    toml:"expiresAt"    yaml:"expiresAt"`
+	Reason       string `json:"reason"       toml:"reason"       yaml:"reason"`
+	Owner        string `json:"owner"        toml:"owner"        yaml:"owner"`
+	Ticket       string `json:"ticket"       toml:"ticket"       yaml:"ticket"`
+	Metadata     struct {
 		Tags []string `json:"tags" toml:"tags" yaml:"tags"`
 	}
 }
@@ -120,6 +448,105 @@ type configLimit struct {
 	Limit   uint `json:"limit"   toml:"limit"   yaml:"limit"`
 }
 
+// defaultSemgrepRiskMatrix weights of 3, 2 and 1 give scores from 1 to 27,
+// high impact and likelihood with at least medium confidence is critical
+func defaultSemgrepRiskMatrix() configSemgrepRiskMatrix {
+	return configSemgrepRiskMatrix{
+		Enabled: false,
+		Weights: configSemgrepRiskWeights{
+			High:   3,
+			Medium: 2,
+			Low:    1,
+		},
+		Thresholds: configSemgrepRiskThresholds{
+			Critical: 18,
+			High:     9,
+			Medium:   4,
+		},
+		RiskLimit: configServerityLimit{
+			Critical: configLimit{
+				Enabled: false,
+				Limit:   0,
+			},
+			High: configLimit{
+				Enabled: false,
+				Limit:   0,
+			},
+			Medium: configLimit{
+				Enabled: false,
+				Limit:   0,
+			},
+			Low: configLimit{
+				Enabled: false,
+				Limit:   0,
+			},
+		},
+		RiskAcceptance: configSemgrepRiskAcceptance{
+			Enabled: false,
+			High:    false,
+			Medium:  false,
+			Low:     false,
+		},
+	}
+}
+
+// defaultReportWithCVEs the default CVE rules shared by Grype, CycloneDX, Trivy and OSV-Scanner
+func defaultReportWithCVEs() ReportWithCVEs {
+	return ReportWithCVEs{
+		SeverityLimit: configCVESeverityLimit{
+			OnlyFixable: false,
+			Critical: configLimit{
+				Enabled: false,
+				Limit:   0,
+			},
+			High: configLimit{
+				Enabled: false,
+				Limit:   0,
+			},
+			Medium: configLimit{
+				Enabled: false,
+				Limit:   0,
+			},
+			Low: configLimit{
+				Enabled: false,
+				Limit:   0,
+			},
+		},
+		EPSSLimit: configEPSSLimit{
+			Enabled:    false,
+			Score:      0,
+			Percentile: 0,
+		},
+		CVSSLimit: configCVSSLimit{
+			Enabled:  false,
+			Score:    0,
+			Versions: []string{"4.0", "3.1", "3.0", "2.0"},
+		},
+		KEVLimitEnabled: false,
+		KEVLimit: configKEVLimit{
+			Mode:            kevLimitModeImmediate,
+			GracePeriodDays: 0,
+		},
+		CVELimit: configCVELimit{
+			Enabled: false,
+			CVEs:    make([]configCVE, 0),
+		},
+		EPSSRiskAcceptance: configEPSSRiskAcceptance{
+			Enabled:    false,
+			Score:      0,
+			Percentile: 0,
+		},
+		CVERiskAcceptance: configCVERiskAcceptance{
+			Enabled: false,
+			CVEs:    make([]configCVE, 0),
+		},
+		FixStateRiskAcceptance: configFixStateRiskAcceptance{
+			Enabled: false,
+			States:  []string{"wont-fix"},
+		},
+	}
+}
+
 func NewDefaultConfig() *Config {
 	return &Config{
 		Version: "1",
@@ -147,8 +574,84 @@ func NewDefaultConfig() *Config {
 				Medium:  false,
 				Low:     false,
 			},
+			RuleIDLimit: configRuleIDList{
+				Enabled: false,
+				RuleIDs: make([]configRuleID, 0),
+			},
+			RuleIDRiskAcceptance: configRuleIDList{
+				Enabled: false,
+				RuleIDs: make([]configRuleID, 0),
+			},
+			CWELimit: configIDList{
+				Enabled: false,
+				IDs:     make([]string, 0),
+			},
+			CWERiskAcceptance: configIDList{
+				Enabled: false,
+				IDs:     make([]string, 0),
+			},
+			OwaspLimit: configIDList{
+				Enabled: false,
+				IDs:     make([]string, 0),
+			},
+			OwaspRiskAcceptance: configIDList{
+				Enabled: false,
+				IDs:     make([]string, 0),
+			},
+			ErrorPolicy: configSemgrepErrorPolicy{
+				ErrorLimit: configLimit{
+					Enabled: false,
+					Limit:   0,
+				},
+				FailLevels:  make([]string, 0),
+				FailTypes:   make([]string, 0),
+				IgnorePaths: make([]string, 0),
+			},
+			RiskMatrix: defaultSemgrepRiskMatrix(),
+		},
+		Grype: defaultReportWithCVEs(),
+		Cyclonedx: configCyclonedx{
+			ReportWithCVEs: defaultReportWithCVEs(),
+			RatingPreference: configRatingPreference{
+				Sources:  []string{},
+				Fallback: artifacts.CyclonedxRatingFallbackHighest,
+			},
+		},
+		Gitleaks: configGitleaksReport{
+			LimitEnabled: false,
+			Allowlist: configGitleaksAllowlist{
+				Enabled: false,
+				Entries: make([]configGitleaksAllow, 0),
+			},
+		},
+		Syft: configSyftReport{
+			PackageLimit: configPackageLimit{
+				Enabled:  false,
+				Packages: make([]configPackage, 0),
+			},
+			RequiredMetadata: configRequiredMetadata{
+				Enabled: false,
+				Version: false,
+				PURL:    false,
+				License: false,
+			},
 		},
-		Grype: reportWithCVEs{
+		Trivy: defaultReportWithCVEs(),
+		Sarif: configSarifReport{
+			LevelLimit: configSarifLevelLimit{
+				Error: configLimit{
+					Enabled: false,
+					Limit:   0,
+				},
+				Warning: configLimit{
+					Enabled: false,
+					Limit:   0,
+				},
+				Note: configLimit{
+					Enabled: false,
+					Limit:   0,
+				},
+			},
 			SeverityLimit: configServerityLimit{
 				Critical: configLimit{
 					Enabled: false,
@@ -167,25 +670,81 @@ func NewDefaultConfig() *Config {
 					Limit:   0,
 				},
 			},
-			EPSSLimit: configEPSSLimit{
+			RuleIDLimit: configRuleIDList{
 				Enabled: false,
-				Score:   0,
+				RuleIDs: make([]configRuleID, 0),
 			},
-			KEVLimitEnabled: false,
-			CVELimit: configCVELimit{
+			RuleIDRiskAcceptance: configRuleIDList{
 				Enabled: false,
-				CVEs:    make([]configCVE, 0),
+				RuleIDs: make([]configRuleID, 0),
+			},
+		},
+		Spdx: configSpdxReport{
+			PackageLimit: configPackageLimit{
+				Enabled:  false,
+				Packages: make([]configPackage, 0),
+			},
+			LicenseLimit: configLicenseLimit{
+				Enabled:  false,
+				Licenses: make([]string, 0),
+			},
+			RequiredMetadata: configRequiredMetadata{
+				Enabled: false,
+				Version: false,
+				PURL:    false,
+				License: false,
+			},
+		},
+		Osv: defaultReportWithCVEs(),
+		Govulncheck: configGovulncheckReport{
+			CalledLimit: configLimit{
+				Enabled: false,
+				Limit:   0,
 			},
-			EPSSRiskAcceptance: configEPSSRiskAcceptance{
+			CVELimit: configCVELimit{
 				Enabled: false,
-				Score:   0,
+				CVEs:    make([]configCVE, 0),
 			},
 			CVERiskAcceptance: configCVERiskAcceptance{
 				Enabled: false,
 				CVEs:    make([]configCVE, 0),
 			},
 		},
-		Cyclonedx: reportWithCVEs{
+		Zap: configZapReport{
+			RiskLimit: configZapRiskLimit{
+				High: configLimit{
+					Enabled: false,
+					Limit:   0,
+				},
+				Medium: configLimit{
+					Enabled: false,
+					Limit:   0,
+				},
+				Low: configLimit{
+					Enabled: false,
+					Limit:   0,
+				},
+				Informational: configLimit{
+					Enabled: false,
+					Limit:   0,
+				},
+			},
+			PluginIDLimit: configPluginIDList{
+				Enabled:   false,
+				PluginIDs: make([]configPluginID, 0),
+			},
+			PluginIDRiskAcceptance: configPluginIDList{
+				Enabled:   false,
+				PluginIDs: make([]configPluginID, 0),
+			},
+			ConfidenceRiskAcceptance: configZapConfidenceRiskAcceptance{
+				Enabled:       false,
+				FalsePositive: false,
+				Low:           false,
+				Medium:        false,
+			},
+		},
+		Iac: configIacReport{
 			SeverityLimit: configServerityLimit{
 				Critical: configLimit{
 					Enabled: false,
@@ -204,27 +763,25 @@ func NewDefaultConfig() *Config {
 					Limit:   0,
 				},
 			},
-			EPSSLimit: configEPSSLimit{
-				Enabled: false,
-				Score:   0,
+			CheckIDLimit: configCheckIDList{
+				Enabled:  false,
+				CheckIDs: make([]configCheckID, 0),
 			},
-			KEVLimitEnabled: false,
-			CVELimit: configCVELimit{
-				Enabled: false,
-				CVEs:    make([]configCVE, 0),
+			CheckIDRiskAcceptance: configCheckIDList{
+				Enabled:  false,
+				CheckIDs: make([]configCheckID, 0),
 			},
-			EPSSRiskAcceptance: configEPSSRiskAcceptance{
+		},
+		Trufflehog: configTrufflehogReport{
+			VerifiedLimit: configLimit{
 				Enabled: false,
-				Score:   0,
+				Limit:   0,
 			},
-			CVERiskAcceptance: configCVERiskAcceptance{
-				Enabled: false,
-				CVEs:    make([]configCVE, 0),
+			UnverifiedLimit: configDetectorLimit{
+				Enabled:   false,
+				Detectors: make([]configDetector, 0),
 			},
 		},
-		Gitleaks: configGitleaksReport{
-			LimitEnabled: false,
-		},
 	}
 }
 
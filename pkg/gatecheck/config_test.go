@@ -0,0 +1,46 @@
+package gatecheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEncodeConfigTo_cyclonedx(t *testing.T) {
+	decoders := map[string]func([]byte, any) error{
+		"json": json.Unmarshal,
+		"yaml": yaml.Unmarshal,
+		"toml": toml.Unmarshal,
+	}
+
+	for format, decode := range decoders {
+		t.Run(format, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Cyclonedx.SeverityLimit.Critical.Enabled = true
+			config.Cyclonedx.RatingPreference.Sources = []string{"NVD"}
+
+			buf := new(bytes.Buffer)
+			if err := EncodeConfigTo(buf, config, format); err != nil {
+				t.Fatal(err)
+			}
+			if n := strings.Count(buf.String(), "ratingPreference"); n != 1 {
+				t.Fatalf("want ratingPreference only in the cyclonedx section got %d occurrences", n)
+			}
+
+			decoded := new(Config)
+			if err := decode(buf.Bytes(), decoded); err != nil {
+				t.Fatal(err)
+			}
+			if !decoded.Cyclonedx.SeverityLimit.Critical.Enabled {
+				t.Fatal("want the embedded cve rules decoded")
+			}
+			if len(decoded.Cyclonedx.RatingPreference.Sources) != 1 || decoded.Cyclonedx.RatingPreference.Sources[0] != "NVD" {
+				t.Fatalf("want sources [NVD] got: %v", decoded.Cyclonedx.RatingPreference.Sources)
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package gatecheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
+)
+
+// Report types used for content detection and the --input-type override
+const (
+	ReportTypeGrype       = "grype"
+	ReportTypeCyclonedx   = "cyclonedx"
+	ReportTypeSemgrep     = "semgrep"
+	ReportTypeGitleaks    = "gitleaks"
+	ReportTypeSyft        = "syft"
+	ReportTypeTrivy       = "trivy"
+	ReportTypeSarif       = "sarif"
+	ReportTypeSpdx        = "spdx"
+	ReportTypeOsv         = "osv"
+	ReportTypeGovulncheck = "govulncheck"
+	ReportTypeZap         = "zap"
+	ReportTypeCheckov     = "checkov"
+	ReportTypeTfsec       = "tfsec"
+	ReportTypeTrufflehog  = "trufflehog"
+	ReportTypeBundle      = "bundle"
+)
+
+// SupportedReportTypes every value accepted as an explicit input type
+var SupportedReportTypes = []string{
+	ReportTypeGrype,
+	ReportTypeCyclonedx,
+	ReportTypeSemgrep,
+	ReportTypeGitleaks,
+	ReportTypeSyft,
+	ReportTypeTrivy,
+	ReportTypeSarif,
+	ReportTypeSpdx,
+	ReportTypeOsv,
+	ReportTypeGovulncheck,
+	ReportTypeZap,
+	ReportTypeCheckov,
+	ReportTypeTfsec,
+	ReportTypeTrufflehog,
+	ReportTypeBundle,
+}
+
+// gzipMagic is the two byte header for any gzip stream, used by gatecheck bundles
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// DetectReportType inspects the content of a report and returns the report type
+//
+// An empty string is returned if the content doesn't match any supported report
+func DetectReportType(content []byte) string {
+	if bytes.HasPrefix(content, gzipMagic) {
+		return ReportTypeBundle
+	}
+
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) == 0 {
+		return ""
+	}
+
+	switch trimmed[0] {
+	case '{':
+		return detectJSONObject(trimmed)
+	case '[':
+		return detectJSONArray(trimmed)
+	case '<':
+		return detectXMLDocument(trimmed)
+	}
+
+	return ""
+}
+
+func detectJSONObject(content []byte) string {
+	object := make(map[string]json.RawMessage)
+	// Only the first JSON value is decoded, trailing content is ignored
+	if err := json.NewDecoder(bytes.NewReader(content)).Decode(&object); err != nil {
+		slog.Debug("detect report type: json object decoding", "error", err)
+		return ""
+	}
+
+	descriptor := struct {
+		Name string `json:"name"`
+	}{}
+	if raw, ok := object["descriptor"]; ok {
+		_ = json.Unmarshal(raw, &descriptor)
+	}
+
+	bomFormat := ""
+	if raw, ok := object["bomFormat"]; ok {
+		_ = json.Unmarshal(raw, &bomFormat)
+	}
+
+	schema := ""
+	if raw, ok := object["$schema"]; ok {
+		_ = json.Unmarshal(raw, &schema)
+	}
+
+	// govulncheck writes a stream of messages, the first is the scanner config
+	scannerConfig := struct {
+		ScannerName string `json:"scanner_name"`
+	}{}
+	if raw, ok := object["config"]; ok {
+		_ = json.Unmarshal(raw, &scannerConfig)
+	}
+
+	spdxVersion := ""
+	if raw, ok := object["spdxVersion"]; ok {
+		_ = json.Unmarshal(raw, &spdxVersion)
+	}
+
+	programName := ""
+	if raw, ok := object["@programName"]; ok {
+		_ = json.Unmarshal(raw, &programName)
+	}
+
+	_, hasMatches := object["matches"]
+	_, hasArtifacts := object["artifacts"]
+	_, hasResults := object["results"]
+	_, hasErrors := object["errors"]
+	_, hasSchemaVersion := object["SchemaVersion"]
+	_, hasArtifactName := object["ArtifactName"]
+	_, hasRuns := object["runs"]
+	_, hasCheckType := object["check_type"]
+	_, hasDetectorName := object["DetectorName"]
+	_, hasSourceMetadata := object["SourceMetadata"]
+
+	switch {
+	case strings.EqualFold(descriptor.Name, "grype"), hasMatches:
+		return ReportTypeGrype
+	case strings.EqualFold(descriptor.Name, "syft") && hasArtifacts:
+		return ReportTypeSyft
+	case strings.EqualFold(bomFormat, "CycloneDX"):
+		return ReportTypeCyclonedx
+	case hasResults && hasErrors:
+		return ReportTypeSemgrep
+	case hasResults && hasCheckType:
+		return ReportTypeCheckov
+	case hasResults && isTfsecResults(object["results"]):
+		return ReportTypeTfsec
+	case hasResults && isOsvResults(object["results"]):
+		return ReportTypeOsv
+	case hasSchemaVersion && hasArtifactName:
+		return ReportTypeTrivy
+	case hasRuns && strings.Contains(strings.ToLower(schema), "sarif"):
+		return ReportTypeSarif
+	case hasRuns && bytes.Equal(object["version"], []byte(`"2.1.0"`)):
+		return ReportTypeSarif
+	case strings.EqualFold(scannerConfig.ScannerName, "govulncheck"):
+		return ReportTypeGovulncheck
+	case strings.HasPrefix(spdxVersion, "SPDX-"):
+		return ReportTypeSpdx
+	case strings.EqualFold(programName, "ZAP"), isZapSites(object["site"]):
+		return ReportTypeZap
+	case hasDetectorName && hasSourceMetadata:
+		return ReportTypeTrufflehog
+	}
+
+	return ""
+}
+
+// isOsvResults osv-scanner writes an empty results array when there are no findings
+func isOsvResults(raw json.RawMessage) bool {
+	results := make([]map[string]json.RawMessage, 0)
+	if err := json.Unmarshal(raw, &results); err != nil {
+		return false
+	}
+	if len(results) == 0 {
+		return true
+	}
+	_, hasPackages := results[0]["packages"]
+	_, hasSource := results[0]["source"]
+	return hasPackages && hasSource
+}
+
+// isZapSites a report without the program name still has sites with alerts
+func isZapSites(raw json.RawMessage) bool {
+	sites := make([]map[string]json.RawMessage, 0)
+	if err := json.Unmarshal(raw, &sites); err != nil || len(sites) == 0 {
+		return false
+	}
+	_, hasAlerts := sites[0]["alerts"]
+	return hasAlerts
+}
+
+// isTfsecResults tfsec writes null results when there are no findings
+func isTfsecResults(raw json.RawMessage) bool {
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return true
+	}
+	results := make([]map[string]json.RawMessage, 0)
+	if err := json.Unmarshal(raw, &results); err != nil || len(results) == 0 {
+		return false
+	}
+	_, hasRuleID := results[0]["rule_id"]
+	_, hasLongID := results[0]["long_id"]
+	return hasRuleID && hasLongID
+}
+
+func detectJSONArray(content []byte) string {
+	elements := make([]map[string]json.RawMessage, 0)
+	if err := json.NewDecoder(bytes.NewReader(content)).Decode(&elements); err != nil {
+		slog.Debug("detect report type: json array decoding", "error", err)
+		return ""
+	}
+
+	// Gitleaks writes an empty array when there are no findings
+	if len(elements) == 0 {
+		return ReportTypeGitleaks
+	}
+
+	if _, ok := elements[0]["RuleID"]; ok {
+		return ReportTypeGitleaks
+	}
+
+	// Checkov writes an array of reports when more than one framework is scanned
+	if _, ok := elements[0]["check_type"]; ok {
+		return ReportTypeCheckov
+	}
+
+	return ""
+}
+
+// detectXMLDocument only the root element is inspected
+func detectXMLDocument(content []byte) string {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			slog.Debug("detect report type: xml decoding", "error", err)
+			return ""
+		}
+
+		root, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if root.Name.Local == "bom" && strings.HasPrefix(root.Name.Space, artifacts.CyclonedxNamespace) {
+			return ReportTypeCyclonedx
+		}
+		return ""
+	}
+}
+
+// resolveReportType use the explicit input type if provided, otherwise detect from content
+func resolveReportType(content []byte, inputType string) (string, error) {
+	inputType = strings.ToLower(strings.TrimSpace(inputType))
+	if inputType != "" {
+		if !slices.Contains(SupportedReportTypes, inputType) {
+			return "", fmt.Errorf("unsupported input type '%s', must be one of %v", inputType, SupportedReportTypes)
+		}
+		slog.Debug("report type set by input type", "report_type", inputType)
+		return inputType, nil
+	}
+
+	reportType := DetectReportType(content)
+	if reportType == "" {
+		return "", fmt.Errorf("unsupported report, cannot determine report type from content, use an explicit input type: %v", SupportedReportTypes)
+	}
+
+	slog.Debug("report type detected from content", "report_type", reportType)
+	return reportType, nil
+}
+
+// readAndResolveReportType buffers the source so the content can be inspected then decoded
+func readAndResolveReportType(src io.Reader, inputType string) ([]byte, string, error) {
+	content, err := io.ReadAll(src)
+	if err != nil {
+		return nil, "", err
+	}
+
+	reportType, err := resolveReportType(content, inputType)
+	return content, reportType, err
+}
@@ -0,0 +1,116 @@
+package gatecheck
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDetectReportType(t *testing.T) {
+	testTable := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "grype", content: `{"matches": [], "descriptor": {"name": "grype", "version": "0.74.0"}}`, want: ReportTypeGrype},
+		{name: "syft", content: `{"artifacts": [], "descriptor": {"name": "syft", "version": "1.0.0"}}`, want: ReportTypeSyft},
+		{name: "cyclonedx", content: `{"bomFormat": "CycloneDX", "specVersion": "1.4"}`, want: ReportTypeCyclonedx},
+		{name: "semgrep", content: `{"errors": [], "results": [], "version": "1.0.0"}`, want: ReportTypeSemgrep},
+		{name: "trivy", content: `{"SchemaVersion": 2, "ArtifactName": "alpine:3.19", "Results": []}`, want: ReportTypeTrivy},
+		{name: "sarif", content: `{"$schema": "https://json.schemastore.org/sarif-2.1.0.json", "version": "2.1.0", "runs": []}`, want: ReportTypeSarif},
+		{name: "sarif-no-schema", content: `{"version": "2.1.0", "runs": []}`, want: ReportTypeSarif},
+		{name: "cyclonedx-xml", content: `<?xml version="1.0"?><bom xmlns="http://cyclonedx.org/schema/bom/1.5" version="1"></bom>`, want: ReportTypeCyclonedx},
+		{name: "unknown-xml", content: `<?xml version="1.0"?><project xmlns="http://maven.apache.org/POM/4.0.0"></project>`, want: ""},
+		{name: "spdx", content: `{"spdxVersion": "SPDX-2.3", "SPDXID": "SPDXRef-DOCUMENT", "packages": []}`, want: ReportTypeSpdx},
+		{name: "osv", content: `{"results": [{"source": {"path": "go.mod", "type": "lockfile"}, "packages": []}]}`, want: ReportTypeOsv},
+		{name: "osv-empty", content: `{"results": []}`, want: ReportTypeOsv},
+		{name: "govulncheck", content: `{"config": {"protocol_version": "v1.0.0", "scanner_name": "govulncheck"}}` + "\n" + `{"finding": {"osv": "GO-2024-2687"}}`, want: ReportTypeGovulncheck},
+		{name: "zap", content: `{"@programName": "ZAP", "@version": "2.15.0", "site": []}`, want: ReportTypeZap},
+		{name: "zap-no-program-name", content: `{"site": [{"@name": "https://example.com", "alerts": []}]}`, want: ReportTypeZap},
+		{name: "site-not-zap", content: `{"site": "https://example.com", "title": "not a report"}`, want: ""},
+		{name: "site-without-alerts", content: `{"site": [{"@name": "https://example.com"}]}`, want: ""},
+		{name: "checkov", content: `{"check_type": "terraform", "results": {"failed_checks": []}, "summary": {}}`, want: ReportTypeCheckov},
+		{name: "checkov-array", content: `[{"check_type": "terraform", "results": {"failed_checks": []}}]`, want: ReportTypeCheckov},
+		{name: "tfsec", content: `{"results": [{"rule_id": "AVD-AWS-0086", "long_id": "aws-s3-block-public-acls"}]}`, want: ReportTypeTfsec},
+		{name: "tfsec-empty", content: `{"results": null}`, want: ReportTypeTfsec},
+		{name: "trufflehog", content: `{"SourceMetadata": {"Data": {"Git": {"file": "a.env"}}}, "DetectorName": "AWS", "Verified": true}` + "\n" + `{"SourceMetadata": {}, "DetectorName": "Slack"}`, want: ReportTypeTrufflehog},
+		{name: "gitleaks", content: `[{"RuleID": "jwt", "File": "main.go"}]`, want: ReportTypeGitleaks},
+		{name: "gitleaks-empty", content: `[]`, want: ReportTypeGitleaks},
+		{name: "bundle", content: "\x1f\x8b\x08\x00", want: ReportTypeBundle},
+		{name: "unknown-object", content: `{"some": "value"}`, want: ""},
+		{name: "unknown-array", content: `[1, 2, 3]`, want: ""},
+		{name: "empty", content: ``, want: ""},
+		{name: "plain-text", content: `grype`, want: ""},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := DetectReportType([]byte(testCase.content))
+			if got != testCase.want {
+				t.Fatalf("want: '%s' got: '%s'", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestDetectReportType_files(t *testing.T) {
+	testTable := []struct {
+		filename string
+		want     string
+	}{
+		{filename: "../../test/grype-report.json", want: ReportTypeGrype},
+		{filename: "../../test/cyclonedx-grype-sbom.json", want: ReportTypeCyclonedx},
+		{filename: "../../test/cyclonedx-syft-sbom.json", want: ReportTypeCyclonedx},
+		{filename: "../../test/cyclonedx-maven-bom.xml", want: ReportTypeCyclonedx},
+		{filename: "../../test/semgrep-sast-report.json", want: ReportTypeSemgrep},
+		{filename: "../../test/gitleaks-report.json", want: ReportTypeGitleaks},
+		{filename: "../../test/trivy-report.json", want: ReportTypeTrivy},
+		{filename: "../../test/sarif-report.json", want: ReportTypeSarif},
+		{filename: "../../test/spdx-sbom.json", want: ReportTypeSpdx},
+		{filename: "../../test/osv-scanner-report.json", want: ReportTypeOsv},
+		{filename: "../../test/govulncheck-report.json", want: ReportTypeGovulncheck},
+		{filename: "../../test/zap-report.json", want: ReportTypeZap},
+		{filename: "../../test/checkov-report.json", want: ReportTypeCheckov},
+		{filename: "../../test/tfsec-report.json", want: ReportTypeTfsec},
+		{filename: "../../test/trufflehog-report.json", want: ReportTypeTrufflehog},
+		{filename: "../../test/known_exploited_vulnerabilities.json", want: ""},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.filename, func(t *testing.T) {
+			content, err := os.ReadFile(testCase.filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := DetectReportType(content)
+			if got != testCase.want {
+				t.Fatalf("want: '%s' got: '%s'", testCase.want, got)
+			}
+		})
+	}
+}
+
+func Test_resolveReportType(t *testing.T) {
+	t.Run("explicit-input-type", func(t *testing.T) {
+		got, err := resolveReportType([]byte(`{"errors": [], "results": []}`), "Grype")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != ReportTypeGrype {
+			t.Fatalf("want: '%s' got: '%s'", ReportTypeGrype, got)
+		}
+	})
+
+	t.Run("unsupported-input-type", func(t *testing.T) {
+		_, err := resolveReportType([]byte(`{}`), "not-a-type")
+		if err == nil {
+			t.Fatal("want error got nil")
+		}
+	})
+
+	t.Run("undetectable", func(t *testing.T) {
+		_, err := resolveReportType([]byte(`{}`), "")
+		if err == nil {
+			t.Fatal("want error got nil")
+		}
+	})
+}
@@ -17,6 +17,12 @@ type fetchOptions struct {
 
 	epssFile io.Reader
 	kevFile  io.Reader
+
+	inputType string
+
+	sarifOutput io.Writer
+
+	validators Validators
 }
 
 func defaultOptions() *fetchOptions {
@@ -27,6 +33,7 @@ func defaultOptions() *fetchOptions {
 		epssURL:    epssDefault.URL,
 		kevClient:  kevDefault.Client,
 		kevURL:     kevDefault.URL,
+		validators: DefaultValidators(),
 	}
 }
 
@@ -68,6 +75,31 @@ func WithKEVFile(r io.Reader) optionFunc {
 	}
 }
 
+// WithInputType optionFunc that overrides content detection of the report type
+//
+// Will detect the report type from content if "" is passed
+func WithInputType(inputType string) optionFunc {
+	return func(o *fetchOptions) {
+		o.inputType = inputType
+	}
+}
+
+// WithSarifOutput optionFunc that writes every finding as a SARIF 2.1.0 log before validation
+//
+// Findings that fail a rule list the rule names in the "failedRules" result property
+func WithSarifOutput(w io.Writer) optionFunc {
+	return func(o *fetchOptions) {
+		o.sarifOutput = w
+	}
+}
+
+// WithValidators optionFunc that validates with custom pipelines, start from DefaultValidators
+func WithValidators(validators Validators) optionFunc {
+	return func(o *fetchOptions) {
+		o.validators = validators
+	}
+}
+
 type optionFunc func(*fetchOptions)
 
 func DownloadEPSS(w io.Writer, optionFuncs ...optionFunc) error {
@@ -0,0 +1,132 @@
+package gatecheck
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// exception a risk acceptance or allowlist entry with an expiration
+type exception struct {
+	reportType string
+	id         string
+	expiresAt  string
+	expiration time.Time
+	invalid    bool
+	owner      string
+	ticket     string
+	reason     string
+}
+
+// ListExceptions write a table of the acceptances that expire within the number of days
+//
+// Expired acceptances and acceptances with an invalid expiration are always listed,
+// acceptances without an expiration never expire and are not listed
+func ListExceptions(dst io.Writer, config *Config, days int) error {
+	now := time.Now()
+	deadline := now.Add(time.Duration(days) * 24 * time.Hour)
+
+	exceptions := slices.DeleteFunc(configExceptions(config), func(e exception) bool {
+		return !e.invalid && (e.expiration.IsZero() || e.expiration.After(deadline))
+	})
+
+	slices.SortStableFunc(exceptions, func(a, b exception) int {
+		if a.invalid || b.invalid {
+			return boolCompare(b.invalid, a.invalid)
+		}
+		return a.expiration.Compare(b.expiration)
+	})
+
+	table := tablewriter.NewWriter(dst)
+	table.SetHeader([]string{"Report", "ID", "Expires At", "Days Left", "Owner", "Ticket", "Reason"})
+	for _, e := range exceptions {
+		daysLeft := "invalid"
+		if !e.invalid {
+			daysLeft = "expired"
+			if remaining := e.expiration.Sub(now); remaining > 0 {
+				daysLeft = fmt.Sprintf("%d", int(remaining/(24*time.Hour)))
+			}
+		}
+		table.Append([]string{e.reportType, e.id, e.expiresAt, daysLeft, dashIfEmpty(e.owner), dashIfEmpty(e.ticket), dashIfEmpty(e.reason)})
+	}
+
+	if len(exceptions) == 0 {
+		table.SetFooter([]string{fmt.Sprintf("No Exceptions Expiring Within %d Days", days)})
+	}
+
+	table.Render()
+	return nil
+}
+
+// configExceptions every acceptance in the config that has an expiration
+func configExceptions(config *Config) []exception {
+	exceptions := []exception{}
+
+	cveAcceptances := []struct {
+		reportType string
+		cves       []configCVE
+	}{
+		{ReportTypeGrype, config.Grype.CVERiskAcceptance.CVEs},
+		{ReportTypeCyclonedx, config.Cyclonedx.CVERiskAcceptance.CVEs},
+		{ReportTypeTrivy, config.Trivy.CVERiskAcceptance.CVEs},
+		{ReportTypeOsv, config.Osv.CVERiskAcceptance.CVEs},
+		{ReportTypeGovulncheck, config.Govulncheck.CVERiskAcceptance.CVEs},
+	}
+
+	for _, acceptance := range cveAcceptances {
+		for _, cve := range acceptance.cves {
+			id := cve.ID
+			if cveScoped(cve) {
+				id = fmt.Sprintf("%s [%s]", cve.ID, cveScopeShort(cve))
+			}
+			exceptions = append(exceptions, newException(acceptance.reportType, id, cve.ExpiresAt, cve.Owner, cve.Ticket, cve.Reason))
+		}
+	}
+
+	for _, entry := range config.Gitleaks.Allowlist.Entries {
+		id := entry.Fingerprint
+		if id == "" {
+			id = strings.Trim(entry.RuleID+" "+entry.File, " ")
+		}
+		exceptions = append(exceptions, newException(ReportTypeGitleaks, id, entry.ExpiresAt, "", "", entry.Reason))
+	}
+
+	return slices.DeleteFunc(exceptions, func(e exception) bool {
+		return strings.TrimSpace(e.expiresAt) == ""
+	})
+}
+
+func newException(reportType, id, expiresAt, owner, ticket, reason string) exception {
+	expiration, err := configExpiration(expiresAt)
+	return exception{
+		reportType: reportType,
+		id:         id,
+		expiresAt:  expiresAt,
+		expiration: expiration,
+		invalid:    err != nil,
+		owner:      owner,
+		ticket:     ticket,
+		reason:     reason,
+	}
+}
+
+func boolCompare(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
+
+func dashIfEmpty(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return "-"
+	}
+	return s
+}
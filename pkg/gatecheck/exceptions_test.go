@@ -0,0 +1,46 @@
+package gatecheck
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListExceptions(t *testing.T) {
+	inWeek := time.Now().AddDate(0, 0, 7).Format(time.DateOnly)
+	inYear := time.Now().AddDate(1, 0, 0).Format(time.DateOnly)
+
+	config := NewDefaultConfig()
+	config.Grype.CVERiskAcceptance.CVEs = []configCVE{
+		{ID: "CVE-2023-0001", ExpiresAt: inWeek, Owner: "platform", Ticket: "SEC-1", Reason: "no fix"},
+		{ID: "CVE-2023-0002", ExpiresAt: inYear},
+		{ID: "CVE-2023-0003"},
+	}
+	config.Osv.CVERiskAcceptance.CVEs = []configCVE{{ID: "GHSA-xxxx", ExpiresAt: "2020-01-01"}}
+	config.Govulncheck.CVERiskAcceptance.CVEs = []configCVE{{ID: "GO-2024-0001", ExpiresAt: "soon"}}
+	config.Gitleaks.Allowlist.Entries = []configGitleaksAllow{{Fingerprint: "abc:config.go:aws:1", ExpiresAt: inWeek}}
+
+	buf := new(bytes.Buffer)
+	if err := ListExceptions(buf, config, 30); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+
+	for _, want := range []string{"CVE-2023-0001", "SEC-1", "GHSA-xxxx", "expired", "GO-2024-0001", "invalid", "abc:config.go:aws:1"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("want %q in output:\n%s", want, output)
+		}
+	}
+	for _, notWant := range []string{"CVE-2023-0002", "CVE-2023-0003"} {
+		if strings.Contains(output, notWant) {
+			t.Fatalf("did not want %q in output:\n%s", notWant, output)
+		}
+	}
+
+	// invalid first, then expired, then soonest
+	if strings.Index(output, "GO-2024-0001") > strings.Index(output, "GHSA-xxxx") ||
+		strings.Index(output, "GHSA-xxxx") > strings.Index(output, "CVE-2023-0001") {
+		t.Fatalf("exceptions not ordered by expiration:\n%s", output)
+	}
+}
@@ -0,0 +1,144 @@
+package gatecheck
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
+	"github.com/gatecheckdev/gatecheck/pkg/kev"
+	"github.com/gatecheckdev/gatecheck/pkg/validate"
+)
+
+const (
+	// kevLimitModeImmediate fail as soon as a vulnerability is in the catalog
+	kevLimitModeImmediate = "immediate"
+	// kevLimitModeDueDate fail after the catalog due date
+	kevLimitModeDueDate = "dueDate"
+	// kevLimitModeGracePeriod fail after a number of days since the vulnerability was added to the catalog
+	kevLimitModeGracePeriod = "gracePeriod"
+)
+
+// kevCatalogEntry case insensitive match on the CVE ID
+func kevCatalogEntry(catalog *kev.Catalog, id string) (kev.Vulnerability, bool) {
+	if catalog == nil {
+		return kev.Vulnerability{}, false
+	}
+	idx := slices.IndexFunc(catalog.Vulnerabilities, func(kevVul kev.Vulnerability) bool {
+		return strings.EqualFold(kevVul.CveID, id)
+	})
+	if idx < 0 {
+		return kev.Vulnerability{}, false
+	}
+	return catalog.Vulnerabilities[idx], true
+}
+
+// kevDeadline the time a catalog entry starts failing the limit, zero fails immediately
+//
+// Known ransomware campaign use always fails immediately.
+// A due date fails at the end of the day, the same as a risk acceptance expiresAt
+func kevDeadline(limit configKEVLimit, entry kev.Vulnerability) (time.Time, error) {
+	if entry.RansomwareKnown() {
+		return time.Time{}, nil
+	}
+
+	switch limit.Mode {
+	case "", kevLimitModeImmediate:
+		return time.Time{}, nil
+	case kevLimitModeDueDate:
+		if strings.TrimSpace(entry.DueDate) == "" {
+			return time.Time{}, fmt.Errorf("kev catalog entry %s has no due date", entry.CveID)
+		}
+		return configExpiration(entry.DueDate)
+	case kevLimitModeGracePeriod:
+		added, err := time.Parse(time.DateOnly, strings.TrimSpace(entry.DateAdded))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("kev catalog entry %s date added: %w", entry.CveID, err)
+		}
+		return added.AddDate(0, 0, int(limit.GracePeriodDays)), nil
+	}
+	return time.Time{}, limit.check()
+}
+
+// check the mode is supported, checked once for each section before validation
+func (limit configKEVLimit) check() error {
+	switch limit.Mode {
+	case "", kevLimitModeImmediate, kevLimitModeDueDate, kevLimitModeGracePeriod:
+		return nil
+	}
+	return fmt.Errorf("%w: unsupported kev limit mode '%s', supported: [%s|%s|%s]",
+		validate.ErrConfig, limit.Mode, kevLimitModeImmediate, kevLimitModeDueDate, kevLimitModeGracePeriod)
+}
+
+// kevLimitViolated an entry without a usable deadline fails so a bad catalog or mode can't hide a KEV
+func kevLimitViolated(limit configKEVLimit, entry kev.Vulnerability, now time.Time) bool {
+	deadline, err := kevDeadline(limit, entry)
+	if err != nil {
+		slog.Warn("kev limit deadline, fail immediately", "cve_id", entry.CveID, "error", err)
+		return true
+	}
+	return !now.Before(deadline)
+}
+
+// ruleKEVLimit fail if any of the findings are in the catalog past their deadline, findings are looked up by CVE
+//
+// Every catalog hit is logged with the due date and required action, including hits before the deadline
+func ruleKEVLimit(findings []artifacts.Finding, config CVERuleConfig) error {
+	artifact, catalog := config.Artifact, config.Catalog
+	if !config.Config.KEVLimitEnabled {
+		slog.Debug("kev limit not enabled", "artifact", artifact)
+		return nil
+	}
+	if catalog == nil {
+		slog.Error("kev limit enabled but no catalog data exists", "artifact", artifact)
+		return validate.NewFailedRuleError(config.rule("kevLimitEnabled"), "no kev catalog")
+	}
+
+	limit := config.Config.KEVLimit
+	now := time.Now()
+	badFindings := make([]artifacts.Finding, 0)
+	inCatalog := 0
+
+	for _, finding := range findings {
+		cveID := finding.CVE()
+		entry, ok := kevCatalogEntry(catalog, cveID)
+		if !ok {
+			continue
+		}
+		inCatalog++
+
+		attrs := []any{
+			"artifact", artifact,
+			"cve_id", cveID,
+			"date_added", entry.DateAdded,
+			"due_date", entry.DueDate,
+			"known_ransomware_campaign_use", entry.KnownRansomwareCampaignUse,
+			"required_action", entry.RequiredAction,
+		}
+
+		if kevLimitViolated(limit, entry, now) {
+			badFindings = append(badFindings, finding)
+			slog.Warn("cve found in kev catalog", attrs...)
+			continue
+		}
+		deadline, _ := kevDeadline(limit, entry)
+		slog.Info("cve found in kev catalog, before kev limit deadline",
+			append(attrs, "kev_limit_mode", limit.Mode, "fails_at", deadline.Format(time.RFC3339))...)
+	}
+
+	if len(badFindings) > 0 {
+		slog.Error("cve(s) found in kev catalog",
+			"artifact", artifact, "vulnerabilities", len(badFindings), "kev_catalog_count", len(catalog.Vulnerabilities))
+		return failedRuleErrs(config.rule("kevLimitEnabled"), badFindings, findingID)
+	}
+	if inCatalog > 0 {
+		slog.Info("kev limit validated, cves in catalog are before the kev limit deadline",
+			"artifact", artifact, "vulnerabilities", len(findings), "in_catalog", inCatalog, "kev_limit_mode", limit.Mode)
+		return nil
+	}
+	slog.Info("kev limit validated, no cves in catalog",
+		"artifact", artifact, "vulnerabilities", len(findings), "kev_catalog_count", len(catalog.Vulnerabilities))
+	return nil
+}
@@ -0,0 +1,93 @@
+package gatecheck
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
+	"github.com/gatecheckdev/gatecheck/pkg/kev"
+	"github.com/gatecheckdev/gatecheck/pkg/validate"
+)
+
+func Test_kevLimitViolated(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	entry := kev.Vulnerability{CveID: "CVE-1", DateAdded: "2024-06-01", DueDate: "2024-06-22", KnownRansomwareCampaignUse: "Unknown"}
+	ransomware := entry
+	ransomware.KnownRansomwareCampaignUse = "Known"
+
+	testTable := []struct {
+		name  string
+		limit configKEVLimit
+		entry kev.Vulnerability
+		want  bool
+	}{
+		{name: "default-immediate", limit: configKEVLimit{}, entry: entry, want: true},
+		{name: "immediate", limit: configKEVLimit{Mode: kevLimitModeImmediate}, entry: entry, want: true},
+		{name: "before-due-date", limit: configKEVLimit{Mode: kevLimitModeDueDate}, entry: entry, want: false},
+		{name: "due-date-is-last-day", limit: configKEVLimit{Mode: kevLimitModeDueDate}, entry: kev.Vulnerability{CveID: "CVE-1", DueDate: "2024-06-15"}, want: false},
+		{name: "after-due-date", limit: configKEVLimit{Mode: kevLimitModeDueDate}, entry: kev.Vulnerability{CveID: "CVE-1", DueDate: "2024-06-14"}, want: true},
+		{name: "missing-due-date", limit: configKEVLimit{Mode: kevLimitModeDueDate}, entry: kev.Vulnerability{CveID: "CVE-1"}, want: true},
+		{name: "within-grace-period", limit: configKEVLimit{Mode: kevLimitModeGracePeriod, GracePeriodDays: 30}, entry: entry, want: false},
+		{name: "after-grace-period", limit: configKEVLimit{Mode: kevLimitModeGracePeriod, GracePeriodDays: 7}, entry: entry, want: true},
+		{name: "ransomware-due-date", limit: configKEVLimit{Mode: kevLimitModeDueDate}, entry: ransomware, want: true},
+		{name: "ransomware-grace-period", limit: configKEVLimit{Mode: kevLimitModeGracePeriod, GracePeriodDays: 30}, entry: ransomware, want: true},
+		{name: "unsupported-mode", limit: configKEVLimit{Mode: "never"}, entry: entry, want: true},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := kevLimitViolated(testCase.limit, testCase.entry, now); got != testCase.want {
+				t.Fatalf("want: %t got: %t", testCase.want, got)
+			}
+		})
+	}
+}
+
+func Test_ruleKEVLimit_modes(t *testing.T) {
+	report := &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+		{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "High"}},
+	}}
+	catalog := kev.NewCatalog()
+	catalog.Vulnerabilities = []kev.Vulnerability{{
+		CveID:          "cve-1",
+		DateAdded:      time.Now().Format(time.DateOnly),
+		DueDate:        time.Now().AddDate(0, 0, 21).Format(time.DateOnly),
+		RequiredAction: "Apply updates per vendor instructions.",
+	}}
+
+	config := NewDefaultConfig()
+	config.Grype.KEVLimitEnabled = true
+	if ruleKEVLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype, Catalog: catalog}) == nil {
+		t.Fatal("want the default immediate mode to fail")
+	}
+
+	config.Grype.KEVLimit.Mode = kevLimitModeDueDate
+	if _, err := validateGrypeRules(DefaultValidators().Grype, config, report, catalog, nil); err != nil {
+		t.Fatalf("want pass before the due date got: %v", err)
+	}
+
+	catalog.Vulnerabilities[0].KnownRansomwareCampaignUse = "Known"
+	if _, err := validateGrypeRules(DefaultValidators().Grype, config, report, catalog, nil); !errors.Is(err, ErrValidationFailure) {
+		t.Fatalf("want: %v for known ransomware use got: %v", ErrValidationFailure, err)
+	}
+}
+
+func TestValidate_kevLimitMode(t *testing.T) {
+	config := NewDefaultConfig()
+	config.Trivy.KEVLimit.Mode = "never"
+
+	err := Validate(config, strings.NewReader(`{"matches": []}`), "grype-report.json")
+	if !errors.Is(err, validate.ErrConfig) {
+		t.Fatalf("want: %v got: %v", validate.ErrConfig, err)
+	}
+	if !strings.Contains(err.Error(), "trivy.kevLimit") {
+		t.Fatalf("want the config key in the error got: %v", err)
+	}
+
+	config.Trivy.KEVLimit.Mode = kevLimitModeGracePeriod
+	if err := Validate(config, strings.NewReader(`{"matches": []}`), "grype-report.json"); errors.Is(err, validate.ErrConfig) {
+		t.Fatalf("want a supported mode to pass the config check got: %v", err)
+	}
+}
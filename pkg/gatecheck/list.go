@@ -1,6 +1,7 @@
 package gatecheck
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -20,6 +21,8 @@ import (
 type listOptions struct {
 	displayFormat string
 	epssData      *epss.Data
+	inputType     string
+	config        *Config
 }
 
 type ListOptionFunc func(*listOptions)
@@ -30,6 +33,23 @@ func WithDisplayFormat(displayFormat string) func(*listOptions) {
 	}
 }
 
+// WithListInputType overrides content detection of the report type
+//
+// Will detect the report type from content if "" is passed
+func WithListInputType(inputType string) func(*listOptions) {
+	return func(o *listOptions) {
+		o.inputType = inputType
+	}
+}
+
+// WithListConfig the gatecheck config used for report options that change how findings are listed,
+// for example the CycloneDX rating preference or the Gitleaks allowlist
+func WithListConfig(config *Config) func(*listOptions) {
+	return func(o *listOptions) {
+		o.config = config
+	}
+}
+
 func WithEPSS(epssFile *os.File, epssURL string) (func(*listOptions), error) {
 	data := &epss.Data{}
 	f := func(o *listOptions) {
@@ -54,38 +74,105 @@ func List(dst io.Writer, src io.Reader, inputFilename string, options ...ListOpt
 		f(o)
 	}
 
-	switch {
-	case strings.Contains(inputFilename, "grype"):
-		slog.Debug("list", "filename", inputFilename, "filetype", "grype")
+	content, reportType, err := readAndResolveReportType(src, o.inputType)
+	if err != nil {
+		slog.Error("cannot determine report type", "filename", inputFilename, "error", err)
+		return errors.New("Failed to list artifact content")
+	}
+
+	slog.Debug("list", "filename", inputFilename, "filetype", reportType)
+
+	ratingPreference := artifacts.CyclonedxRatingPreference{}
+	if o.config != nil {
+		ratingPreference = o.config.Cyclonedx.RatingPreference.cyclonedx()
+	}
+
+	if o.displayFormat == "sarif" {
+		// only the rating preference and the semgrep risk matrix are used, validation rules aren't annotated when listing
+		var sarifConfig *Config
+		if o.config != nil {
+			sarifConfig = &Config{
+				Cyclonedx: configCyclonedx{RatingPreference: o.config.Cyclonedx.RatingPreference},
+				Semgrep:   configSemgrepReport{RiskMatrix: o.config.Semgrep.RiskMatrix},
+			}
+		}
+		runs, err := sarifRunsFrom(content, reportType, sarifConfig, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		return writeSarif(dst, runs)
+	}
+
+	src = bytes.NewReader(content)
+
+	switch reportType {
+	case ReportTypeGrype:
 		if o.epssData != nil {
 			table, err = listGrypeWithEPSS(dst, src, o.epssData)
 		} else {
 			table, err = ListGrypeReport(dst, src)
 		}
 
-	case strings.Contains(inputFilename, "cyclonedx"):
-		slog.Debug("list", "filename", inputFilename, "filetype", "cyclonedx")
+	case ReportTypeCyclonedx:
 		if o.epssData != nil {
-			table, err = listCyclonedxWithEPSS(dst, src, o.epssData)
+			table, err = listCyclonedxWithEPSS(dst, src, o.epssData, ratingPreference)
 		} else {
-			table, err = ListCyclonedx(dst, src)
+			table, err = listCyclonedx(dst, src, ratingPreference)
 		}
 
-	case strings.Contains(inputFilename, "semgrep"):
-		slog.Debug("list", "filename", inputFilename, "filetype", "semgrep")
-		table, err = ListSemgrep(dst, src)
+	case ReportTypeSemgrep:
+		table, err = ListSemgrep(dst, src, WithListConfig(o.config))
+
+	case ReportTypeGitleaks:
+		table, err = listGitleaks(dst, src, o.config)
+
+	case ReportTypeTrufflehog:
+		table, err = listTrufflehog(dst, src)
+
+	case ReportTypeSyft:
+		table, err = listSyft(dst, src)
+
+	case ReportTypeTrivy:
+		if o.epssData != nil {
+			table, err = listTrivyWithEPSS(dst, src, o.epssData)
+		} else {
+			table, err = listTrivy(dst, src)
+		}
+
+	case ReportTypeOsv:
+		if o.epssData != nil {
+			table, err = listOsvWithEPSS(dst, src, o.epssData)
+		} else {
+			table, err = listOsv(dst, src)
+		}
+
+	case ReportTypeGovulncheck:
+		table, err = listGovulncheck(dst, src)
+
+	case ReportTypeSarif:
+		table, err = listSarif(dst, src)
 
-	case strings.Contains(inputFilename, "gitleaks"):
-		slog.Debug("list", "filename", inputFilename, "filetype", "gitleaks")
-		table, err = listGitleaks(dst, src)
+	case ReportTypeSpdx:
+		table, err = listSpdx(dst, src)
 
-	case strings.Contains(inputFilename, "syft"):
-		slog.Debug("list", "filename", inputFilename, "filetype", "syft")
-		slog.Warn("syft decoder is not supported yet")
-		return errors.New("syft not implemented yet")
+	case ReportTypeZap:
+		table, err = listZap(dst, src)
 
-	case strings.Contains(inputFilename, "bundle") || strings.Contains(inputFilename, "gatecheck"):
-		slog.Debug("list", "filename", inputFilename, "filetype", "bundle")
+	case ReportTypeCheckov:
+		report := &artifacts.IacReportMin{}
+		if err := artifacts.DecodeCheckov(src, report); err != nil {
+			return err
+		}
+		table, err = listIac(dst, report)
+
+	case ReportTypeTfsec:
+		report := &artifacts.IacReportMin{}
+		if err := artifacts.DecodeTfsec(src, report); err != nil {
+			return err
+		}
+		table, err = listIac(dst, report)
+
+	case ReportTypeBundle:
 		bundle := archive.NewBundle()
 		if err := archive.UntarGzipBundle(src, bundle); err != nil {
 			return err
@@ -94,7 +181,7 @@ func List(dst io.Writer, src io.Reader, inputFilename string, options ...ListOpt
 		return err
 
 	default:
-		slog.Error("unsupported file type, cannot be determined from filename", "filename", inputFilename)
+		slog.Error("unsupported file type", "filename", inputFilename, "filetype", reportType)
 		return errors.New("Failed to list artifact content")
 	}
 
@@ -125,12 +212,13 @@ func ListGrypeReport(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
 	matrix := format.NewSortableMatrix(make([][]string, 0), 0, catLess)
 
 	for _, item := range report.Matches {
-		row := []string{item.Vulnerability.Severity, item.Artifact.Name, item.Artifact.Version, item.Vulnerability.DataSource}
+		fixed := listFixedIn(item.Vulnerability.FixState(), item.Vulnerability.Fix.Versions)
+		row := []string{item.Vulnerability.Severity, item.Artifact.Name, item.Artifact.Version, fixed, item.Vulnerability.DataSource}
 		matrix.Append(row)
 	}
 	sort.Sort(matrix)
 
-	header := []string{"Grype Severity", "Package", "Version", "Link"}
+	header := []string{"Grype Severity", "Package", "Version", "Fixed In", "Link"}
 
 	table := matrix.Table(dst, header)
 
@@ -163,6 +251,7 @@ func listGrypeWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data) (*tabl
 			prctl,
 			item.Artifact.Name,
 			item.Artifact.Version,
+			listFixedIn(item.Vulnerability.FixState(), item.Vulnerability.Fix.Versions),
 			item.Vulnerability.DataSource,
 		}
 		matrix.Append(row)
@@ -175,6 +264,7 @@ func listGrypeWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data) (*tabl
 		"EPSS Prctl",
 		"Package",
 		"Version",
+		"Fixed In",
 		"Link",
 	}
 
@@ -185,39 +275,45 @@ func listGrypeWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data) (*tabl
 	return table, nil
 }
 
+// ListCyclonedx list with the highest severity of every rating
 func ListCyclonedx(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	return listCyclonedx(dst, src, artifacts.CyclonedxRatingPreference{})
+}
+
+func listCyclonedx(dst io.Writer, src io.Reader, preference artifacts.CyclonedxRatingPreference) (*tablewriter.Table, error) {
 	report := &artifacts.CyclonedxReportMin{}
-	slog.Debug("decode cyclonedx report", "format", "json")
-	if err := json.NewDecoder(src).Decode(&report); err != nil {
+	slog.Debug("decode cyclonedx report")
+	if err := artifacts.DecodeCyclonedx(src, report); err != nil {
 		return nil, err
 	}
 
-	catLess := format.NewCatagoricLess([]string{"critical", "high", "medium", "low", "none"})
+	catLess := format.NewCatagoricLess([]string{"critical", "high", "medium", "low", "info", "none", "unknown"})
 	matrix := format.NewSortableMatrix(make([][]string, 0), 1, catLess)
 
-	link := "-"
 	for idx, vul := range report.Vulnerabilities {
-		severity := vul.HighestSeverity()
+		severity := vul.Severity(preference)
 		pkgs := report.AffectedPackages(idx)
+		link := "-"
 		if len(vul.Advisories) > 0 {
 			link = vul.Advisories[0].URL
 		}
+		fixed := listFixedIn(vul.FixState(), vul.FixedVersions())
 		// get the affected vulnerability
-		matrix.Append([]string{vul.ID, severity, pkgs, link})
+		matrix.Append([]string{vul.ID, severity, pkgs, fixed, link})
 	}
 
 	sort.Sort(matrix)
 
-	header := []string{"Cyclonedx CVE ID", "Severity", "Package", "Link"}
+	header := []string{"Cyclonedx CVE ID", "Severity", "Package", "Fixed In", "Link"}
 	table := matrix.Table(dst, header)
 
 	return table, nil
 }
 
-func listCyclonedxWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data) (*tablewriter.Table, error) {
+func listCyclonedxWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data, preference artifacts.CyclonedxRatingPreference) (*tablewriter.Table, error) {
 	report := &artifacts.CyclonedxReportMin{}
-	slog.Debug("decode grype report", "format", "json")
-	if err := json.NewDecoder(src).Decode(&report); err != nil {
+	slog.Debug("decode cyclonedx report")
+	if err := artifacts.DecodeCyclonedx(src, report); err != nil {
 		return nil, err
 	}
 
@@ -238,10 +334,11 @@ func listCyclonedxWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data) (*
 		}
 		row := []string{
 			item.ID,
-			item.HighestSeverity(),
+			item.Severity(preference),
 			score,
 			prctl,
 			report.AffectedPackages(idx),
+			listFixedIn(item.FixState(), item.FixedVersions()),
 			link,
 		}
 		matrix.Append(row)
@@ -249,13 +346,220 @@ func listCyclonedxWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data) (*
 
 	sort.Sort(matrix)
 
-	header := []string{"Cyclonedx CVE ID", "Severity", "EPSS Score", "EPSS Prctl", "affected Packages", "Link"}
+	header := []string{"Cyclonedx CVE ID", "Severity", "EPSS Score", "EPSS Prctl", "affected Packages", "Fixed In", "Link"}
+	table := matrix.Table(dst, header)
+
+	return table, nil
+}
+
+func listTrivy(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	report := &artifacts.TrivyReportMin{}
+	slog.Debug("decode trivy report", "format", "json")
+	if err := json.NewDecoder(src).Decode(report); err != nil {
+		return nil, err
+	}
+
+	catLess := format.NewCatagoricLess([]string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "UNKNOWN"})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 1, catLess)
+
+	for _, vulnerability := range report.AllVulnerabilities() {
+		row := []string{
+			vulnerability.VulnerabilityID,
+			vulnerability.Severity,
+			vulnerability.PkgName,
+			vulnerability.InstalledVersion,
+			listFixedIn(vulnerability.FixState(), vulnerability.FixedVersions()),
+			vulnerability.PrimaryURL,
+		}
+		matrix.Append(row)
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"Trivy CVE ID", "Severity", "Package", "Version", "Fixed In", "Link"}
+	table := matrix.Table(dst, header)
+
+	return table, nil
+}
+
+func listTrivyWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data) (*tablewriter.Table, error) {
+	report := &artifacts.TrivyReportMin{}
+	slog.Debug("decode trivy report", "format", "json")
+	if err := json.NewDecoder(src).Decode(report); err != nil {
+		return nil, err
+	}
+
+	catLess := format.NewCatagoricLess([]string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "UNKNOWN"})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 1, catLess)
+
+	for _, vulnerability := range report.AllVulnerabilities() {
+		cve, ok := epssData.CVEs[vulnerability.VulnerabilityID]
+		score := "-"
+		prctl := "-"
+		if ok {
+			score = cve.EPSS
+			prctl = cve.Percentile
+		}
+
+		row := []string{
+			vulnerability.VulnerabilityID,
+			vulnerability.Severity,
+			score,
+			prctl,
+			vulnerability.PkgName,
+			vulnerability.InstalledVersion,
+			listFixedIn(vulnerability.FixState(), vulnerability.FixedVersions()),
+			vulnerability.PrimaryURL,
+		}
+		matrix.Append(row)
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"Trivy CVE ID", "Severity", "EPSS Score", "EPSS Prctl", "Package", "Version", "Fixed In", "Link"}
+	table := matrix.Table(dst, header)
+
+	return table, nil
+}
+
+func listOsv(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	report := &artifacts.OsvReportMin{}
+	slog.Debug("decode osv report", "format", "json")
+	if err := json.NewDecoder(src).Decode(report); err != nil {
+		return nil, err
+	}
+
+	catLess := format.NewCatagoricLess([]string{"critical", "high", "medium", "low", "unknown"})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 2, catLess)
+
+	for _, result := range report.Results {
+		for _, pkg := range result.Packages {
+			for _, vulnerability := range pkg.Vulnerabilities {
+				row := []string{
+					vulnerability.ID,
+					vulnerability.CVE(),
+					vulnerability.Severity(),
+					pkg.Package.Name,
+					pkg.Package.Version,
+					listFixedIn(vulnerability.FixState(), vulnerability.FixedVersions()),
+					pkg.Package.Ecosystem,
+				}
+				matrix.Append(row)
+			}
+		}
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"OSV ID", "CVE ID", "Severity", "Package", "Version", "Fixed In", "Ecosystem"}
+	table := matrix.Table(dst, header)
+
+	return table, nil
+}
+
+func listOsvWithEPSS(dst io.Writer, src io.Reader, epssData *epss.Data) (*tablewriter.Table, error) {
+	report := &artifacts.OsvReportMin{}
+	slog.Debug("decode osv report", "format", "json")
+	if err := json.NewDecoder(src).Decode(report); err != nil {
+		return nil, err
+	}
+
+	catLess := format.NewCatagoricLess([]string{"critical", "high", "medium", "low", "unknown"})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 2, catLess)
+
+	for _, result := range report.Results {
+		for _, pkg := range result.Packages {
+			for _, vulnerability := range pkg.Vulnerabilities {
+				// EPSS is keyed by CVE, the alias is used when the primary ID is a GHSA or PYSEC ID
+				cve, ok := epssData.CVEs[vulnerability.CVE()]
+				score := "-"
+				prctl := "-"
+				if ok {
+					score = cve.EPSS
+					prctl = cve.Percentile
+				}
+
+				row := []string{
+					vulnerability.ID,
+					vulnerability.CVE(),
+					vulnerability.Severity(),
+					score,
+					prctl,
+					pkg.Package.Name,
+					pkg.Package.Version,
+					listFixedIn(vulnerability.FixState(), vulnerability.FixedVersions()),
+					pkg.Package.Ecosystem,
+				}
+				matrix.Append(row)
+			}
+		}
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"OSV ID", "CVE ID", "Severity", "EPSS Score", "EPSS Prctl", "Package", "Version", "Fixed In", "Ecosystem"}
+	table := matrix.Table(dst, header)
+
+	return table, nil
+}
+
+func listGovulncheck(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	report := &artifacts.GovulncheckReportMin{}
+	slog.Debug("decode govulncheck report", "format", "json stream")
+	if err := artifacts.DecodeGovulncheck(src, report); err != nil {
+		return nil, err
+	}
+
+	levelLess := format.NewCatagoricLess([]string{
+		artifacts.GovulncheckLevelCalled,
+		artifacts.GovulncheckLevelImported,
+		artifacts.GovulncheckLevelRequired,
+	})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 2, levelLess)
+
+	vulnerabilities := report.Vulnerabilities()
+	for _, vulnerability := range vulnerabilities {
+		symbol, calledFrom := "-", "-"
+		if vulnerability.Call != nil {
+			symbol = vulnerability.Symbol
+			calledFrom = fmt.Sprintf("%s %s", vulnerability.Call.Symbol(), vulnerability.Call.PositionShort())
+		}
+		fixedVersion := vulnerability.FixedVersion
+		if fixedVersion == "" {
+			fixedVersion = "-"
+		}
+		row := []string{
+			vulnerability.OSV.ID,
+			vulnerability.OSV.CVE(),
+			vulnerability.Level,
+			vulnerability.Module,
+			vulnerability.Version,
+			fixedVersion,
+			symbol,
+			format.Summarize(calledFrom, 50, format.ClipMiddle),
+		}
+		matrix.Append(row)
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"Govulncheck OSV ID", "CVE ID", "Level", "Module", "Version", "Fixed Version", "Symbol", "Called From"}
 	table := matrix.Table(dst, header)
 
+	if len(vulnerabilities) == 0 {
+		table.SetFooter([]string{"No govulncheck Findings"})
+	}
+
 	return table, nil
 }
 
-func ListSemgrep(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+// ListSemgrep the computed risk uses the risk matrix from WithListConfig, the default risk matrix without a config
+func ListSemgrep(dst io.Writer, src io.Reader, options ...ListOptionFunc) (*tablewriter.Table, error) {
+	o := &listOptions{}
+	for _, f := range options {
+		f(o)
+	}
+
 	report := &artifacts.SemgrepReportMin{}
 
 	if err := json.NewDecoder(src).Decode(report); err != nil {
@@ -265,6 +569,7 @@ func ListSemgrep(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
 	for _, semgrepError := range report.Errors {
 		slog.Warn("semgrep runtime error",
 			"level", semgrepError.Level,
+			"type", semgrepError.TypeName(),
 			"message", semgrepError.ShortMessage(),
 			"path", semgrepError.Path,
 		)
@@ -272,7 +577,12 @@ func ListSemgrep(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
 
 	catLess := format.NewCatagoricLess([]string{"ERROR", "WARNING", "INFO"})
 
-	matrix := format.NewSortableMatrix(make([][]string, 0), 1, catLess)
+	matrix := format.NewSortableMatrix(make([][]string, 0), 2, catLess)
+
+	riskMatrix := defaultSemgrepRiskMatrix()
+	if o.config != nil {
+		riskMatrix = o.config.Semgrep.RiskMatrix
+	}
 
 	for _, result := range report.Results {
 		row := []string{
@@ -280,6 +590,7 @@ func ListSemgrep(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
 			result.Extra.Metadata.OwaspIDs(),
 			result.Extra.Severity,
 			result.Extra.Metadata.Impact,
+			semgrepRisk(riskMatrix, result.Extra.Metadata),
 			result.Extra.Metadata.Shortlink,
 		}
 		matrix.Append(row)
@@ -287,13 +598,83 @@ func ListSemgrep(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
 
 	sort.Sort(matrix)
 
-	header := []string{"Semgrep Check ID", "Owasp IDs", "Severity", "Impact", "link"}
+	header := []string{"Semgrep Check ID", "Owasp IDs", "Severity", "Impact", "Computed Risk", "link"}
+	table := matrix.Table(dst, header)
+
+	return table, nil
+}
+
+func listZap(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	report := &artifacts.ZapReportMin{}
+	if err := json.NewDecoder(src).Decode(report); err != nil {
+		return nil, err
+	}
+
+	catLess := format.NewCatagoricLess([]string{"high", "medium", "low", "informational", "unknown"})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 2, catLess)
+
+	count := 0
+	for _, site := range report.Sites {
+		for _, alert := range site.Alerts {
+			count++
+			cwe := "-"
+			if alert.CWEID != "" && alert.CWEID != "-1" && alert.CWEID != "0" {
+				cwe = "CWE-" + alert.CWEID
+			}
+			row := []string{
+				alert.PluginID,
+				format.Summarize(alert.Alert, 50, format.ClipRight),
+				alert.Risk(),
+				alert.ConfidenceLevel(),
+				cwe,
+				site.Name,
+				fmt.Sprintf("%d", len(alert.Instances)),
+			}
+			matrix.Append(row)
+		}
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"ZAP Plugin ID", "Alert", "Risk", "Confidence", "CWE", "Site", "Instances"}
+	table := matrix.Table(dst, header)
+
+	if count == 0 {
+		table.SetFooter([]string{"No ZAP Alerts"})
+	}
+
+	return table, nil
+}
+
+func listIac(dst io.Writer, report *artifacts.IacReportMin) (*tablewriter.Table, error) {
+	catLess := format.NewCatagoricLess([]string{"critical", "high", "medium", "low", "unknown"})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 1, catLess)
+
+	for _, finding := range report.Findings {
+		row := []string{
+			finding.CheckID,
+			finding.Severity,
+			format.Summarize(finding.Resource, 50, format.ClipMiddle),
+			format.Summarize(finding.LocationShort(), 50, format.ClipLeft),
+			format.Summarize(finding.Description, 50, format.ClipRight),
+		}
+		matrix.Append(row)
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{report.Tool + " Check ID", "Severity", "Resource", "Location", "Description"}
 	table := matrix.Table(dst, header)
 
+	if len(report.Findings) == 0 {
+		table.SetFooter([]string{"No " + report.Tool + " Findings"})
+	}
+
 	return table, nil
 }
 
-func listGitleaks(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+// listGitleaks the accepted and reason columns are added when there's a config with the allowlist
+func listGitleaks(dst io.Writer, src io.Reader, config *Config) (*tablewriter.Table, error) {
 	report := &artifacts.GitLeaksReportMin{}
 	if err := json.NewDecoder(src).Decode(report); err != nil {
 		return nil, err
@@ -301,14 +682,25 @@ func listGitleaks(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
 
 	table := tablewriter.NewWriter(dst)
 
-	table.SetHeader([]string{"Gitleaks Rule ID", "File", "Commit", "Start Line"})
+	header := []string{"Gitleaks Rule ID", "File", "Commit", "Start Line", "Author", "Date"}
+	if config != nil {
+		header = append(header, "Accepted", "Reason")
+	}
+	table.SetHeader(header)
 
 	for _, finding := range *report {
+		date, _, _ := strings.Cut(finding.Date, "T")
 		row := []string{
 			finding.RuleID,
 			finding.FileShort(),
 			finding.CommitShort(),
 			fmt.Sprintf("%d", finding.StartLine),
+			finding.Author,
+			date,
+		}
+		if config != nil {
+			entry, accepted := gitleaksAllowEntry(config, finding)
+			row = append(row, fmt.Sprintf("%t", accepted), entry.Reason)
 		}
 		table.Append(row)
 	}
@@ -319,3 +711,150 @@ func listGitleaks(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
 
 	return table, nil
 }
+
+func listTrufflehog(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	report := &artifacts.TrufflehogReportMin{}
+	slog.Debug("decode trufflehog report", "format", "json lines")
+	if err := artifacts.DecodeTrufflehog(src, report); err != nil {
+		return nil, err
+	}
+
+	catLess := format.NewCatagoricLess([]string{"true", "false"})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 1, catLess)
+
+	for _, finding := range *report {
+		row := []string{
+			finding.DetectorName,
+			fmt.Sprintf("%t", finding.Verified),
+			format.Summarize(finding.LocationShort(), 50, format.ClipMiddle),
+			finding.CommitShort(),
+			finding.SourceName,
+		}
+		matrix.Append(row)
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"TruffleHog Detector", "Verified", "Location", "Commit", "Source"}
+	table := matrix.Table(dst, header)
+
+	if len(*report) == 0 {
+		table.SetFooter([]string{"No TruffleHog Findings"})
+	}
+
+	return table, nil
+}
+
+func listSpdx(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	report := &artifacts.SpdxReportMin{}
+	slog.Debug("decode spdx report", "format", "json")
+	if err := json.NewDecoder(src).Decode(report); err != nil {
+		return nil, err
+	}
+
+	matrix := format.NewSortableMatrix(make([][]string, 0), 0, format.AlphabeticLess)
+
+	for _, pkg := range report.Packages {
+		purl := pkg.PURL()
+		if purl == "" {
+			purl = "-"
+		}
+		row := []string{
+			pkg.Name,
+			pkg.VersionInfo,
+			artifacts.SpdxLicenseShort(pkg.LicenseDeclared),
+			artifacts.SpdxLicenseShort(pkg.LicenseConcluded),
+			format.Summarize(purl, 60, format.ClipMiddle),
+			fmt.Sprintf("%d", len(report.RelationshipsFrom(pkg.SPDXID, "DEPENDS_ON"))),
+		}
+		matrix.Append(row)
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"SPDX Package", "Version", "Declared License", "Concluded License", "PURL", "Depends On"}
+	table := matrix.Table(dst, header)
+
+	if len(report.Packages) == 0 {
+		table.SetFooter([]string{"No SPDX Packages"})
+	}
+
+	return table, nil
+}
+
+func listSyft(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	report := &artifacts.SyftReportMin{}
+	slog.Debug("decode syft report", "format", "json")
+	if err := json.NewDecoder(src).Decode(report); err != nil {
+		return nil, err
+	}
+
+	matrix := format.NewSortableMatrix(make([][]string, 0), 0, format.AlphabeticLess)
+
+	for _, pkg := range report.Artifacts {
+		row := []string{
+			pkg.Name,
+			pkg.Version,
+			pkg.Type,
+			pkg.LicensesShort(),
+			pkg.LocationShort(),
+		}
+		matrix.Append(row)
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"Syft Package", "Version", "Type", "Licenses", "Location"}
+	table := matrix.Table(dst, header)
+
+	if len(report.Artifacts) == 0 {
+		table.SetFooter([]string{"No Syft Packages"})
+	}
+
+	return table, nil
+}
+
+func listSarif(dst io.Writer, src io.Reader) (*tablewriter.Table, error) {
+	report := &artifacts.SarifReportMin{}
+	slog.Debug("decode sarif report", "format", "json")
+	if err := json.NewDecoder(src).Decode(report); err != nil {
+		return nil, err
+	}
+
+	catLess := format.NewCatagoricLess([]string{"critical", "high", "medium", "low", "none"})
+	matrix := format.NewSortableMatrix(make([][]string, 0), 2, catLess)
+
+	findings := report.Findings()
+	for _, finding := range findings {
+		row := []string{
+			format.Summarize(finding.RuleID, 50, format.ClipMiddle),
+			finding.Level,
+			finding.Severity,
+			finding.Tool,
+			finding.LocationShort(),
+		}
+		matrix.Append(row)
+	}
+
+	sort.Sort(matrix)
+
+	header := []string{"SARIF Rule ID", "Level", "Severity", "Tool", "Location"}
+	table := matrix.Table(dst, header)
+
+	if len(findings) == 0 {
+		table.SetFooter([]string{"No SARIF Results"})
+	}
+
+	return table, nil
+}
+
+// listFixedIn the fixed versions, the fix state if there are none, "-" if the state is unknown
+func listFixedIn(fixState string, versions []string) string {
+	if len(versions) > 0 {
+		return strings.Join(versions, ", ")
+	}
+	if fixState == artifacts.FixStateUnknown {
+		return "-"
+	}
+	return fixState
+}
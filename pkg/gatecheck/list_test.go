@@ -0,0 +1,89 @@
+package gatecheck
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestList_gitleaksAccepted(t *testing.T) {
+	content, err := os.ReadFile("../../test/gitleaks-report.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("no-config", func(t *testing.T) {
+		output := new(bytes.Buffer)
+		if err := List(output, bytes.NewReader(content), "gitleaks-report.json"); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(strings.ToLower(output.String()), "accepted") {
+			t.Fatalf("did not want accepted column in output:\n%s", output.String())
+		}
+	})
+
+	t.Run("allowlist", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Gitleaks.Allowlist.Enabled = true
+		config.Gitleaks.Allowlist.Entries = []configGitleaksAllow{
+			{Fingerprint: "1d1571854621f9fa4150e6fae93b24504d4e5a11:cypress/integration/e2e/forgedJwt.spec.ts:jwt:22", Reason: "test token"},
+		}
+
+		output := new(bytes.Buffer)
+		if err := List(output, bytes.NewReader(content), "gitleaks-report.json", WithListConfig(config)); err != nil {
+			t.Fatal(err)
+		}
+
+		accepted := 0
+		for _, line := range strings.Split(output.String(), "\n") {
+			if !strings.Contains(line, "| true ") {
+				continue
+			}
+			accepted++
+			if !strings.Contains(line, "jwt") || !strings.Contains(line, "test token") {
+				t.Fatalf("want accepted jwt finding with reason got: %s", line)
+			}
+		}
+		if accepted != 1 {
+			t.Fatalf("want: 1 accepted finding got: %d\n%s", accepted, output.String())
+		}
+		if !strings.Contains(output.String(), "| false ") {
+			t.Fatalf("want findings that aren't accepted in output:\n%s", output.String())
+		}
+	})
+}
+
+func TestListSemgrep_riskMatrix(t *testing.T) {
+	content := `{"results": [{"check_id": "rules.sql-injection", "path": "app.js", "start": {"line": 1},
+  "extra": {"severity": "ERROR", "metadata": {"impact": "HIGH", "likelihood": "HIGH", "confidence": "HIGH"}}}],
+  "errors": []}`
+
+	t.Run("default", func(t *testing.T) {
+		output := new(bytes.Buffer)
+		table, err := ListSemgrep(output, strings.NewReader(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		table.Render()
+		if !strings.Contains(output.String(), "critical") {
+			t.Fatalf("want critical computed risk in output:\n%s", output.String())
+		}
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Semgrep.RiskMatrix.Weights = configSemgrepRiskWeights{High: 2, Medium: 1, Low: 1}
+		config.Semgrep.RiskMatrix.Thresholds = configSemgrepRiskThresholds{Critical: 27, High: 8, Medium: 2}
+
+		output := new(bytes.Buffer)
+		table, err := ListSemgrep(output, strings.NewReader(content), WithListConfig(config))
+		if err != nil {
+			t.Fatal(err)
+		}
+		table.Render()
+		if strings.Contains(output.String(), "critical") || !strings.Contains(output.String(), "high") {
+			t.Fatalf("want high computed risk in output:\n%s", output.String())
+		}
+	})
+}
@@ -0,0 +1,528 @@
+package gatecheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/gatecheckdev/gatecheck/pkg/archive"
+	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
+	"github.com/gatecheckdev/gatecheck/pkg/epss"
+	"github.com/gatecheckdev/gatecheck/pkg/kev"
+	"github.com/gatecheckdev/gatecheck/pkg/validate"
+)
+
+// sarifPropertyFailedRules the result property with the config keys of every failed rule
+const sarifPropertyFailedRules = "failedRules"
+
+// sarifAnnotation the validation outcome for a single finding
+//
+// Rule names are the config keys, for example "grype.severityLimit.critical"
+type sarifAnnotation struct {
+	failedRules []string
+	acceptedBy  string
+	// kevEntry the KEV catalog entry, nil if the finding isn't in the catalog
+	kevEntry *kev.Vulnerability
+}
+
+func (a sarifAnnotation) apply(result *artifacts.SarifResult) {
+	if len(a.failedRules) > 0 {
+		result.Properties[sarifPropertyFailedRules] = a.failedRules
+	}
+	if a.kevEntry != nil {
+		result.Properties["kevDueDate"] = a.kevEntry.DueDate
+		result.Properties["kevRequiredAction"] = a.kevEntry.RequiredAction
+		result.Properties["kevKnownRansomwareCampaignUse"] = a.kevEntry.KnownRansomwareCampaignUse
+	}
+	if a.acceptedBy != "" {
+		result.Suppressions = append(result.Suppressions, artifacts.SarifSuppression{
+			Kind:          "external",
+			Justification: fmt.Sprintf("risk accepted by %s", a.acceptedBy),
+		})
+	}
+}
+
+// sarifAnnotations the failed rules and acceptance of each result from the validation outcomes,
+// results aren't annotated without outcomes
+//
+// artifact is the config section, allow rules are named by their key in the section
+func sarifAnnotations(artifact string, outcomes []validate.Outcome, count int) []sarifAnnotation {
+	annotations := make([]sarifAnnotation, count)
+	for i, outcome := range outcomes {
+		annotations[i].failedRules = outcome.FailedRules
+		if outcome.AllowedBy != "" {
+			annotations[i].acceptedBy = artifact + "." + outcome.AllowedBy
+		}
+	}
+	return annotations
+}
+
+// sarifLevel map a report severity to a SARIF level
+func sarifLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "critical", "high", "error":
+		return "error"
+	case "medium", "warning":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func newSarifResult(ruleID string, severity string, message string, uri string, line int) artifacts.SarifResult {
+	result := artifacts.SarifResult{
+		RuleID:     ruleID,
+		Level:      sarifLevel(severity),
+		Message:    artifacts.SarifMessage{Text: message},
+		Properties: map[string]any{"severity": severity},
+	}
+	if uri == "" {
+		return result
+	}
+
+	location := artifacts.SarifLocation{
+		PhysicalLocation: artifacts.SarifPhysicalLocation{
+			ArtifactLocation: artifacts.SarifArtifactLocation{URI: uri},
+		},
+	}
+	if line > 0 {
+		location.PhysicalLocation.Region = &artifacts.SarifRegion{StartLine: line}
+	}
+	result.Locations = append(result.Locations, location)
+	return result
+}
+
+func newSarifRun(toolName string, toolVersion string) artifacts.SarifRun {
+	return artifacts.SarifRun{
+		Tool:    artifacts.SarifTool{Driver: artifacts.SarifDriver{Name: toolName, Version: toolVersion}},
+		Results: make([]artifacts.SarifResult, 0),
+	}
+}
+
+// SARIF Runs
+//
+// Results are annotated from the outcomes of the validation rules, outcomes are nil when listing
+
+func grypeSarifRun(report *artifacts.GrypeReportMin, catalog *kev.Catalog, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("grype", report.Descriptor.Version)
+
+	findings := report.NormalizedFindings()
+	annotations := sarifAnnotations("grype", outcomes, len(findings))
+
+	for i, match := range report.Matches {
+		uri := strings.TrimPrefix(findings[i].Location, "/")
+		message := fmt.Sprintf("%s in %s %s", match.Vulnerability.ID, match.Artifact.Name, match.Artifact.Version)
+		result := newSarifResult(match.Vulnerability.ID, match.Vulnerability.Severity, message, uri, 0)
+		result.Properties["package"] = match.Artifact.Name
+		result.Properties["version"] = match.Artifact.Version
+		result.Properties["link"] = match.Vulnerability.DataSource
+		result.Properties["fixState"] = findings[i].FixState
+		result.Properties["fixedVersions"] = match.Vulnerability.Fix.Versions
+		annotations[i].kevEntry = sarifKEVEntry(catalog, findings[i])
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+// cyclonedxSarifRun config may be nil, only the rating preference is used
+func cyclonedxSarifRun(report *artifacts.CyclonedxReportMin, config *Config, catalog *kev.Catalog, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("cyclonedx", "")
+
+	preference := artifacts.CyclonedxRatingPreference{}
+	if config != nil {
+		preference = config.Cyclonedx.RatingPreference.cyclonedx()
+	}
+
+	findings := report.NormalizedFindings(preference)
+	annotations := sarifAnnotations("cyclonedx", outcomes, len(findings))
+
+	for i, vulnerability := range report.Vulnerabilities {
+		pkgs := report.AffectedPackages(i)
+		message := fmt.Sprintf("%s in %s", vulnerability.ID, pkgs)
+		result := newSarifResult(vulnerability.ID, findings[i].Severity, message, "", 0)
+		result.Properties["package"] = pkgs
+		if len(vulnerability.Advisories) > 0 {
+			result.Properties["link"] = vulnerability.Advisories[0].URL
+		}
+		annotations[i].kevEntry = sarifKEVEntry(catalog, findings[i])
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+func trivySarifRun(report *artifacts.TrivyReportMin, catalog *kev.Catalog, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("trivy", "")
+
+	findings := report.NormalizedFindings()
+	annotations := sarifAnnotations("trivy", outcomes, len(findings))
+
+	for i, finding := range findings {
+		vulnerability := finding.Raw.(artifacts.TrivyVulnerability)
+		message := fmt.Sprintf("%s in %s %s", vulnerability.VulnerabilityID, vulnerability.PkgName, vulnerability.InstalledVersion)
+		result := newSarifResult(vulnerability.VulnerabilityID, vulnerability.Severity, message, finding.Location, 0)
+		result.Properties["package"] = vulnerability.PkgName
+		result.Properties["version"] = vulnerability.InstalledVersion
+		result.Properties["link"] = vulnerability.PrimaryURL
+		annotations[i].kevEntry = sarifKEVEntry(catalog, finding)
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+func osvSarifRun(report *artifacts.OsvReportMin, catalog *kev.Catalog, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("osv-scanner", "")
+
+	findings := report.NormalizedFindings()
+	annotations := sarifAnnotations("osv", outcomes, len(findings))
+
+	for i, finding := range findings {
+		pkg := finding.Packages[0]
+		message := fmt.Sprintf("%s in %s %s", finding.ID, pkg.Name, pkg.Version)
+		uri := strings.TrimPrefix(finding.Location, "/")
+		result := newSarifResult(finding.ID, finding.Severity, message, uri, 0)
+		result.Properties["package"] = pkg.Name
+		result.Properties["version"] = pkg.Version
+		result.Properties["aliases"] = finding.Aliases
+		annotations[i].kevEntry = sarifKEVEntry(catalog, finding)
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+// sarifKEVEntry the catalog entry of the finding's CVE, nil if it isn't in the catalog
+func sarifKEVEntry(catalog *kev.Catalog, finding artifacts.Finding) *kev.Vulnerability {
+	entry, ok := kevCatalogEntry(catalog, finding.CVE())
+	if !ok {
+		return nil
+	}
+	return &entry
+}
+
+func govulncheckSarifRun(report *artifacts.GovulncheckReportMin, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("govulncheck", report.Config.ScannerVersion)
+
+	vulnerabilities := report.Vulnerabilities()
+	annotations := sarifAnnotations("govulncheck", outcomes, len(vulnerabilities))
+
+	for i, vulnerability := range vulnerabilities {
+		// only called vulnerabilities can fail validation, the level reflects that
+		severity := "note"
+		if vulnerability.Level == artifacts.GovulncheckLevelCalled {
+			severity = "error"
+		}
+
+		uri, line := "", 0
+		if vulnerability.Call != nil && vulnerability.Call.Position != nil {
+			uri, line = vulnerability.Call.Position.Filename, vulnerability.Call.Position.Line
+		}
+
+		message := fmt.Sprintf("%s in %s %s (%s)", vulnerability.OSV.ID, vulnerability.Module, vulnerability.Version, vulnerability.Level)
+		result := newSarifResult(vulnerability.OSV.ID, severity, message, uri, line)
+		delete(result.Properties, "severity")
+		result.Properties["level"] = vulnerability.Level
+		result.Properties["aliases"] = vulnerability.OSV.Aliases
+		result.Properties["fixedVersion"] = vulnerability.FixedVersion
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+// semgrepSarifRun config may be nil, the computed risk is only a property with the risk matrix enabled
+func semgrepSarifRun(report *artifacts.SemgrepReportMin, config *Config, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("semgrep", report.Version)
+
+	annotations := sarifAnnotations("semgrep", outcomes, len(report.Results))
+
+	for i, semgrepResult := range report.Results {
+		result := newSarifResult(
+			semgrepResult.CheckID,
+			semgrepResult.Extra.Severity,
+			semgrepResult.Extra.Message,
+			semgrepResult.Path,
+			semgrepResult.Start.Line,
+		)
+		result.Properties["impact"] = semgrepResult.Extra.Metadata.Impact
+		if config != nil && config.Semgrep.RiskMatrix.Enabled {
+			result.Properties["risk"] = semgrepRisk(config.Semgrep.RiskMatrix, semgrepResult.Extra.Metadata)
+		}
+		result.Properties["link"] = semgrepResult.Extra.Metadata.Shortlink
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+func gitleaksSarifRun(report *artifacts.GitLeaksReportMin, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("gitleaks", "")
+
+	annotations := sarifAnnotations("gitleaks", outcomes, len(*report))
+
+	for i, finding := range *report {
+		message := fmt.Sprintf("%s detected in commit %s", finding.Description, finding.Commit)
+		result := newSarifResult(finding.RuleID, "error", message, finding.File, finding.StartLine)
+		result.Properties["fingerprint"] = finding.Fingerprint
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+func zapSarifRun(report *artifacts.ZapReportMin, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("zap", report.Version)
+
+	alerts := report.AllAlerts()
+	annotations := sarifAnnotations("zap", outcomes, len(alerts))
+
+	for i, alert := range alerts {
+		uri := ""
+		if len(alert.Instances) > 0 {
+			uri = alert.Instances[0].URI
+		}
+		result := newSarifResult(alert.PluginID, alert.Risk(), alert.Alert, uri, 0)
+		result.Properties["confidence"] = alert.ConfidenceLevel()
+		result.Properties["cweid"] = alert.CWEID
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+func iacSarifRun(report *artifacts.IacReportMin, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun(report.Tool, "")
+
+	annotations := sarifAnnotations("iac", outcomes, len(report.Findings))
+
+	for i, finding := range report.Findings {
+		message := fmt.Sprintf("%s: %s", finding.Resource, finding.Description)
+		result := newSarifResult(finding.CheckID, finding.Severity, message, finding.File, finding.StartLine)
+		result.Properties["resource"] = finding.Resource
+		if finding.Link != "" {
+			result.Properties["link"] = finding.Link
+		}
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+func trufflehogSarifRun(report *artifacts.TrufflehogReportMin, outcomes []validate.Outcome) artifacts.SarifRun {
+	run := newSarifRun("trufflehog", "")
+
+	annotations := sarifAnnotations("trufflehog", outcomes, len(*report))
+
+	for i, finding := range *report {
+		severity := "warning"
+		if finding.Verified {
+			severity = "error"
+		}
+		message := fmt.Sprintf("%s secret detected, verified: %t", finding.DetectorName, finding.Verified)
+		source := finding.Source()
+		result := newSarifResult(finding.DetectorName, severity, message, source.File, source.Line)
+		result.Properties["verified"] = finding.Verified
+		result.Properties["commit"] = source.Commit
+		annotations[i].apply(&result)
+		run.Results = append(run.Results, result)
+	}
+
+	return run
+}
+
+// sarifRunsFrom decode the content and build a run for the report, or a run for each file in a bundle
+//
+// With validators the report is validated once and the results are annotated with the outcome of the rules,
+// the runs are returned with the validation failure. Without validators, when listing, nothing is validated
+func sarifRunsFrom(content []byte, reportType string, config *Config, catalog *kev.Catalog, data *epss.Data, validators *Validators) ([]artifacts.SarifRun, error) {
+	var outcomes []validate.Outcome
+	var err error
+	switch reportType {
+	case ReportTypeGrype:
+		report := &artifacts.GrypeReportMin{}
+		if err = json.Unmarshal(content, report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateGrypeRules(validators.Grype, config, report, catalog, data)
+		}
+		return []artifacts.SarifRun{grypeSarifRun(report, catalog, outcomes)}, err
+	case ReportTypeCyclonedx:
+		report := &artifacts.CyclonedxReportMin{}
+		if err = artifacts.DecodeCyclonedx(bytes.NewReader(content), report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateCyclonedxRules(validators.Cyclonedx, config, report, catalog, data)
+		}
+		return []artifacts.SarifRun{cyclonedxSarifRun(report, config, catalog, outcomes)}, err
+	case ReportTypeTrivy:
+		report := &artifacts.TrivyReportMin{}
+		if err = json.Unmarshal(content, report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateTrivyRules(validators.Trivy, config, report, catalog, data)
+		}
+		return []artifacts.SarifRun{trivySarifRun(report, catalog, outcomes)}, err
+	case ReportTypeOsv:
+		report := &artifacts.OsvReportMin{}
+		if err = json.Unmarshal(content, report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateOsvRules(validators.Osv, config, report, catalog, data)
+		}
+		return []artifacts.SarifRun{osvSarifRun(report, catalog, outcomes)}, err
+	case ReportTypeGovulncheck:
+		report := &artifacts.GovulncheckReportMin{}
+		if err = artifacts.DecodeGovulncheck(bytes.NewReader(content), report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateGovulncheckRules(validators.Govulncheck, config, report)
+		}
+		return []artifacts.SarifRun{govulncheckSarifRun(report, outcomes)}, err
+	case ReportTypeSemgrep:
+		report := &artifacts.SemgrepReportMin{}
+		if err = json.Unmarshal(content, report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateSemgrepRules(validators.Semgrep, config, report)
+		}
+		return []artifacts.SarifRun{semgrepSarifRun(report, config, outcomes)}, err
+	case ReportTypeGitleaks:
+		report := &artifacts.GitLeaksReportMin{}
+		if err = json.Unmarshal(content, report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateGitleaksRules(validators.Gitleaks, config, report)
+		}
+		return []artifacts.SarifRun{gitleaksSarifRun(report, outcomes)}, err
+	case ReportTypeZap:
+		report := &artifacts.ZapReportMin{}
+		if err = json.Unmarshal(content, report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateZapRules(validators.Zap, config, report)
+		}
+		return []artifacts.SarifRun{zapSarifRun(report, outcomes)}, err
+	case ReportTypeCheckov, ReportTypeTfsec:
+		report := &artifacts.IacReportMin{}
+		decode := artifacts.DecodeCheckov
+		if reportType == ReportTypeTfsec {
+			decode = artifacts.DecodeTfsec
+		}
+		if err = decode(bytes.NewReader(content), report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateIacRules(validators.Iac, config, report)
+		}
+		return []artifacts.SarifRun{iacSarifRun(report, outcomes)}, err
+	case ReportTypeTrufflehog:
+		report := &artifacts.TrufflehogReportMin{}
+		if err = artifacts.DecodeTrufflehog(bytes.NewReader(content), report); err != nil {
+			break
+		}
+		if validators != nil {
+			outcomes, err = validateTrufflehogRules(validators.Trufflehog, config, report)
+		}
+		return []artifacts.SarifRun{trufflehogSarifRun(report, outcomes)}, err
+	case ReportTypeBundle:
+		bundle := archive.NewBundle()
+		if err = archive.UntarGzipBundle(bytes.NewReader(content), bundle); err != nil {
+			break
+		}
+		return sarifBundleRuns(bundle, config, catalog, data, validators)
+	default:
+		return nil, fmt.Errorf("SARIF output is not supported for '%s' reports", reportType)
+	}
+
+	slog.Error("decode report for sarif output", "filetype", reportType, "error", err)
+	return nil, errors.New("Cannot write SARIF output: Report decoding failed. See log for details.")
+}
+
+// sarifBundleRuns a run for each file in the bundle
+//
+// Files without SARIF output are skipped, with validators they are still validated
+func sarifBundleRuns(bundle *archive.Bundle, config *Config, catalog *kev.Catalog, data *epss.Data, validators *Validators) ([]artifacts.SarifRun, error) {
+	runs := make([]artifacts.SarifRun, 0)
+	var errs error
+	for fileLabel, descriptor := range bundle.Manifest().Files {
+		fileContent := bundle.FileBytes(fileLabel)
+		reportType := DetectReportType(fileContent)
+		if validators != nil {
+			slog.Info("gatecheck bundle validation", "file_label", fileLabel, "filetype", reportType, "digest", descriptor.Digest)
+		}
+		fileRuns, err := sarifRunsFrom(fileContent, reportType, config, catalog, data, validators)
+		runs = append(runs, fileRuns...)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrValidationFailure):
+			errs = errors.Join(errs, err)
+		default:
+			slog.Warn("skip file in bundle for sarif output", "file_label", fileLabel, "error", err)
+			if validators != nil {
+				errs = errors.Join(errs, validateContentFrom(fileContent, reportType, config, catalog, data, *validators))
+			}
+		}
+	}
+	if errs != nil {
+		return runs, errors.Join(newValidationErr("Gatecheck Bundle"), errs)
+	}
+	return runs, nil
+}
+
+func writeSarif(w io.Writer, runs []artifacts.SarifRun) error {
+	log := artifacts.SarifReportMin{
+		Schema:  artifacts.SarifSchema,
+		Version: artifacts.SarifVersion,
+		Runs:    runs,
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+// validateWithSarifOutput write every finding as a SARIF result annotated by the validation rules
+//
+// External data is loaded once, the report is validated once and the SARIF log is written before
+// the validation failure is returned
+func validateWithSarifOutput(w io.Writer, content []byte, reportType string, config *Config, options *fetchOptions) error {
+	catalog := kev.NewCatalog()
+	epssData := new(epss.Data)
+
+	if err := LoadCatalogAndData(config, catalog, epssData, options); err != nil {
+		slog.Error("validate with sarif output: load epss data from file or api", "error", err)
+		return errors.New("Cannot run validation: Cannot load external validation data. See log for details.")
+	}
+
+	runs, validationErr := sarifRunsFrom(content, reportType, config, catalog, epssData, &options.validators)
+	if validationErr != nil && !errors.Is(validationErr, ErrValidationFailure) {
+		return validationErr
+	}
+
+	if err := writeSarif(w, runs); err != nil {
+		return err
+	}
+	return validationErr
+}
@@ -0,0 +1,172 @@
+package gatecheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
+	"github.com/gatecheckdev/gatecheck/pkg/kev"
+	"github.com/gatecheckdev/gatecheck/pkg/validate"
+)
+
+func Test_sarifRunsFrom_outcomes(t *testing.T) {
+	report := &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+		{Vulnerability: artifacts.GrypeVulnerability{ID: "cve-1", Severity: "Critical"}},
+		{Vulnerability: artifacts.GrypeVulnerability{ID: "cve-2", Severity: "Critical"}},
+		{Vulnerability: artifacts.GrypeVulnerability{ID: "cve-3", Severity: "High"}},
+	}}
+	content, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := new(Config)
+	config.Grype.SeverityLimit.Critical.Enabled = true
+	config.Grype.SeverityLimit.Critical.Limit = 0
+	config.Grype.CVERiskAcceptance.Enabled = true
+	config.Grype.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-1"}}
+
+	catalog := kev.NewCatalog()
+	catalog.Vulnerabilities = []kev.Vulnerability{{CveID: "cve-2", DueDate: "2024-01-01"}}
+
+	validators := DefaultValidators()
+	runs, err := sarifRunsFrom(content, ReportTypeGrype, config, catalog, nil, &validators)
+	if !errors.Is(err, ErrValidationFailure) {
+		t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+	}
+	results := runs[0].Results
+
+	wantSuppressions := []artifacts.SarifSuppression{{Kind: "external", Justification: "risk accepted by grype.cveRiskAcceptance"}}
+	if _, failed := results[0].Properties[sarifPropertyFailedRules]; failed || !slices.Equal(results[0].Suppressions, wantSuppressions) {
+		t.Fatalf("want accepted got: %+v", results[0])
+	}
+
+	wantRules := []string{"grype.severityLimit.critical"}
+	if got, _ := results[1].Properties[sarifPropertyFailedRules].([]string); !slices.Equal(got, wantRules) {
+		t.Fatalf("want: %v got: %v", wantRules, got)
+	}
+	if results[1].Properties["kevDueDate"] != "2024-01-01" {
+		t.Fatalf("want kev properties got: %+v", results[1].Properties)
+	}
+
+	if _, failed := results[2].Properties[sarifPropertyFailedRules]; failed {
+		t.Fatalf("want no failed rules got: %+v", results[2].Properties)
+	}
+}
+
+func TestValidate_sarifOutput(t *testing.T) {
+	content, err := os.ReadFile("../../test/semgrep-sast-report.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := new(Config)
+	config.Semgrep.SeverityLimit.Error.Enabled = true
+	config.Semgrep.SeverityLimit.Error.Limit = 0
+
+	output := new(bytes.Buffer)
+	err = Validate(config, bytes.NewReader(content), "semgrep-sast-report.json", WithSarifOutput(output))
+	if !errors.Is(err, ErrValidationFailure) {
+		t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+	}
+
+	sarifLog := artifacts.SarifReportMin{}
+	if err := json.NewDecoder(output).Decode(&sarifLog); err != nil {
+		t.Fatal(err)
+	}
+
+	semgrepReport := artifacts.SemgrepReportMin{}
+	if err := json.Unmarshal(content, &semgrepReport); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sarifLog.Runs) != 1 || len(sarifLog.Runs[0].Results) != len(semgrepReport.Results) {
+		t.Fatalf("want every finding as a result, got: %d runs", len(sarifLog.Runs))
+	}
+
+	for _, result := range sarifLog.Runs[0].Results {
+		_, failed := result.Properties[sarifPropertyFailedRules]
+		if result.Level == "error" && !failed {
+			t.Fatalf("want failed rule for %s", result.RuleID)
+		}
+		if result.Level != "error" && failed {
+			t.Fatalf("want no failed rule for %s", result.RuleID)
+		}
+	}
+}
+
+func TestValidate_sarifOutputCustomRules(t *testing.T) {
+	report := &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+		{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Critical"}},
+		{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-2", Severity: "Critical"}},
+	}}
+	content, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validators := DefaultValidators()
+	validators.Grype = validators.Grype.
+		WithNamedAllowRule("customRiskAcceptance", func(finding artifacts.Finding, _ CVERuleConfig) bool {
+			return finding.ID == "CVE-1"
+		}).
+		WithValidationRules(func(findings []artifacts.Finding, config CVERuleConfig) error {
+			return validate.DenyFunc(findings, func(finding artifacts.Finding) error {
+				return validate.NewFailedRuleError(config.Artifact+".customLimit", finding.Key())
+			})
+		})
+
+	output := new(bytes.Buffer)
+	err = Validate(NewDefaultConfig(), bytes.NewReader(content), "grype-report.json",
+		WithInputType(ReportTypeGrype), WithValidators(validators), WithSarifOutput(output))
+	if !errors.Is(err, ErrValidationFailure) {
+		t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+	}
+
+	sarifLog := artifacts.SarifReportMin{}
+	if err := json.NewDecoder(output).Decode(&sarifLog); err != nil {
+		t.Fatal(err)
+	}
+	results := sarifLog.Runs[0].Results
+
+	wantSuppressions := []artifacts.SarifSuppression{{Kind: "external", Justification: "risk accepted by grype.customRiskAcceptance"}}
+	if !slices.Equal(results[0].Suppressions, wantSuppressions) {
+		t.Fatalf("want: %v got: %v", wantSuppressions, results[0].Suppressions)
+	}
+
+	if got := fmt.Sprint(results[1].Properties[sarifPropertyFailedRules]); got != "[grype.customLimit]" {
+		t.Fatalf("want: [grype.customLimit] got: %s", got)
+	}
+}
+
+func TestList_sarifFormat(t *testing.T) {
+	f, err := os.Open("../../test/gitleaks-report.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := new(bytes.Buffer)
+	if err := List(output, f, "gitleaks-report.json", WithDisplayFormat("sarif")); err != nil {
+		t.Fatal(err)
+	}
+
+	sarifLog := artifacts.SarifReportMin{}
+	if err := json.NewDecoder(output).Decode(&sarifLog); err != nil {
+		t.Fatal(err)
+	}
+
+	if sarifLog.Version != artifacts.SarifVersion {
+		t.Fatalf("want: %s got: %s", artifacts.SarifVersion, sarifLog.Version)
+	}
+
+	for _, result := range sarifLog.Runs[0].Results {
+		if _, ok := result.Properties[sarifPropertyFailedRules]; ok {
+			t.Fatalf("want no annotations when listing, got: %+v", result.Properties)
+		}
+	}
+}
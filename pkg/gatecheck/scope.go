@@ -0,0 +1,211 @@
+package gatecheck
+
+import (
+	"cmp"
+	"strings"
+	"unicode"
+
+	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
+)
+
+// cveScoped the entry only applies to matching packages
+func cveScoped(cve configCVE) bool {
+	return cve.Package != "" || cve.Purl != "" || cve.VersionRange != "" || cve.ArtifactType != ""
+}
+
+// cveCoversPackages an unscoped entry covers every package,
+// a scoped entry covers a finding only if every affected package matches
+//
+// A scoped entry never covers a finding without package information
+func cveCoversPackages(cve configCVE, packages []artifacts.FindingPackage) bool {
+	if !cveScoped(cve) {
+		return true
+	}
+	if len(packages) == 0 {
+		return false
+	}
+	for _, pkg := range packages {
+		if !cvePackageMatch(cve, pkg) {
+			return false
+		}
+	}
+	return true
+}
+
+// cveScopeShort the package scope for display, "" if unscoped
+func cveScopeShort(cve configCVE) string {
+	parts := []string{}
+	for _, part := range []string{cve.ArtifactType, cve.Package, cve.Purl, cve.VersionRange} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func cvePackageMatch(cve configCVE, pkg artifacts.FindingPackage) bool {
+	if cve.Package != "" && !strings.EqualFold(cve.Package, pkg.Name) {
+		return false
+	}
+	if cve.Purl != "" && !purlMatch(cve.Purl, pkg.Purl) {
+		return false
+	}
+	if cve.ArtifactType != "" && !strings.EqualFold(cve.ArtifactType, pkg.Type) && !strings.EqualFold(cve.ArtifactType, purlType(pkg.Purl)) {
+		return false
+	}
+	if cve.VersionRange != "" && !versionInRange(pkg.Version, cve.VersionRange) {
+		return false
+	}
+	return true
+}
+
+// purlMatch qualifiers and subpaths are ignored, a pattern without a version matches every version
+func purlMatch(pattern string, purl string) bool {
+	patternBase, patternVersion := purlSplit(pattern)
+	base, version := purlSplit(purl)
+	if !strings.EqualFold(patternBase, base) {
+		return false
+	}
+	return patternVersion == "" || patternVersion == version
+}
+
+// purlSplit the purl without qualifiers or subpath split into pkg:type/namespace/name and version
+func purlSplit(purl string) (string, string) {
+	purl, _, _ = strings.Cut(strings.TrimSpace(purl), "#")
+	purl, _, _ = strings.Cut(purl, "?")
+	nameStart := strings.LastIndex(purl, "/") + 1
+	if idx := strings.Index(purl[nameStart:], "@"); idx >= 0 {
+		return purl[:nameStart+idx], purl[nameStart+idx+1:]
+	}
+	return purl, ""
+}
+
+// purlType the type from pkg:type/..., "" if it isn't a purl
+func purlType(purl string) string {
+	rest, ok := strings.CutPrefix(purl, "pkg:")
+	if !ok {
+		return ""
+	}
+	purlType, _, _ := strings.Cut(rest, "/")
+	return purlType
+}
+
+// versionInRange comma separated constraints that must all be true, for example ">=1.2.0, <1.3.0"
+//
+// Operators are =, ==, !=, >, >=, < and <=, a constraint without an operator is an exact match
+func versionInRange(version string, versionRange string) bool {
+	if strings.TrimSpace(version) == "" {
+		return false
+	}
+	for _, constraint := range strings.Split(versionRange, ",") {
+		constraint = strings.TrimSpace(constraint)
+		if constraint == "" {
+			continue
+		}
+		operator := "="
+		for _, op := range []string{">=", "<=", "!=", "==", ">", "<", "="} {
+			if strings.HasPrefix(constraint, op) {
+				operator = op
+				constraint = strings.TrimSpace(strings.TrimPrefix(constraint, op))
+				break
+			}
+		}
+
+		c := compareVersions(version, constraint)
+		var ok bool
+		switch operator {
+		case "=", "==":
+			ok = c == 0
+		case "!=":
+			ok = c != 0
+		case ">":
+			ok = c > 0
+		case ">=":
+			ok = c >= 0
+		case "<":
+			ok = c < 0
+		case "<=":
+			ok = c <= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// versionToken a run of digits or letters and the separator before it
+type versionToken struct {
+	separator rune
+	value     string
+	numeric   bool
+}
+
+// compareVersions a loose ordering for semantic, distro and calendar versions
+//
+// Digits compare numerically and letters alphabetically.
+// A letter suffix after '-' or '~' is a pre-release and sorts before the release, 1.0.0-rc1 < 1.0.0 < 1.0.0a
+func compareVersions(a string, b string) int {
+	aTokens := versionTokens(strings.TrimPrefix(strings.TrimSpace(a), "v"))
+	bTokens := versionTokens(strings.TrimPrefix(strings.TrimSpace(b), "v"))
+
+	for i := 0; i < min(len(aTokens), len(bTokens)); i++ {
+		if c := compareVersionTokens(aTokens[i], bTokens[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(aTokens) > len(bTokens):
+		if versionPreRelease(aTokens[len(bTokens)]) {
+			return -1
+		}
+		return 1
+	case len(aTokens) < len(bTokens):
+		if versionPreRelease(bTokens[len(aTokens)]) {
+			return 1
+		}
+		return -1
+	}
+	return 0
+}
+
+func compareVersionTokens(a versionToken, b versionToken) int {
+	switch {
+	case a.numeric && b.numeric:
+		aValue, bValue := strings.TrimLeft(a.value, "0"), strings.TrimLeft(b.value, "0")
+		if c := cmp.Compare(len(aValue), len(bValue)); c != 0 {
+			return c
+		}
+		return cmp.Compare(aValue, bValue)
+	case a.numeric:
+		return 1
+	case b.numeric:
+		return -1
+	}
+	return cmp.Compare(strings.ToLower(a.value), strings.ToLower(b.value))
+}
+
+func versionPreRelease(token versionToken) bool {
+	return !token.numeric && (token.separator == '-' || token.separator == '~')
+}
+
+func versionTokens(version string) []versionToken {
+	tokens := []versionToken{}
+	separator := rune(0)
+	for _, r := range version {
+		isDigit, isLetter := unicode.IsDigit(r), unicode.IsLetter(r)
+		if !isDigit && !isLetter {
+			separator = r
+			continue
+		}
+		last := len(tokens) - 1
+		if last >= 0 && separator == 0 && tokens[last].numeric == isDigit {
+			tokens[last].value += string(r)
+			continue
+		}
+		tokens = append(tokens, versionToken{separator: separator, value: string(r), numeric: isDigit})
+		separator = 0
+	}
+	return tokens
+}
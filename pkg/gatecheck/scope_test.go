@@ -0,0 +1,171 @@
+package gatecheck
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
+)
+
+func Test_compareVersions(t *testing.T) {
+	testTable := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.2.3", b: "1.2.3", want: 0},
+		{a: "v1.2.3", b: "1.2.3", want: 0},
+		{a: "1.2.10", b: "1.2.9", want: 1},
+		{a: "1.2", b: "1.2.1", want: -1},
+		{a: "1.0.0-rc1", b: "1.0.0", want: -1},
+		{a: "1.1.1n", b: "1.1.1", want: 1},
+		{a: "1.1.1n", b: "1.1.1o", want: -1},
+		{a: "1.1.1n-0+deb11u4", b: "1.1.1n-0+deb11u5", want: -1},
+		{a: "2.35-0ubuntu3.1", b: "2.35-0ubuntu3.1", want: 0},
+		{a: "007", b: "7", want: 0},
+	}
+
+	for _, testCase := range testTable {
+		if got := compareVersions(testCase.a, testCase.b); got != testCase.want {
+			t.Errorf("compareVersions(%q, %q) want: %d got: %d", testCase.a, testCase.b, testCase.want, got)
+		}
+	}
+}
+
+func Test_versionInRange(t *testing.T) {
+	testTable := []struct {
+		version, versionRange string
+		want                  bool
+	}{
+		{version: "1.2.5", versionRange: ">=1.2.0, <1.3.0", want: true},
+		{version: "1.3.0", versionRange: ">=1.2.0, <1.3.0", want: false},
+		{version: "1.2.5", versionRange: "1.2.5", want: true},
+		{version: "1.2.5", versionRange: "!=1.2.5", want: false},
+		{version: "", versionRange: "<2.0.0", want: false},
+	}
+
+	for _, testCase := range testTable {
+		if got := versionInRange(testCase.version, testCase.versionRange); got != testCase.want {
+			t.Errorf("versionInRange(%q, %q) want: %t got: %t", testCase.version, testCase.versionRange, testCase.want, got)
+		}
+	}
+}
+
+func Test_purlMatch(t *testing.T) {
+	purl := "pkg:deb/debian/libssl1.1@1.1.1n-0+deb11u4?arch=amd64&distro=debian-11"
+	testTable := []struct {
+		pattern string
+		want    bool
+	}{
+		{pattern: "pkg:deb/debian/libssl1.1", want: true},
+		{pattern: "pkg:deb/debian/libssl1.1@1.1.1n-0+deb11u4", want: true},
+		{pattern: "pkg:deb/debian/libssl1.1@1.1.1n-0+deb11u5", want: false},
+		{pattern: "pkg:deb/debian/libssl3", want: false},
+	}
+
+	for _, testCase := range testTable {
+		if got := purlMatch(testCase.pattern, purl); got != testCase.want {
+			t.Errorf("purlMatch(%q) want: %t got: %t", testCase.pattern, testCase.want, got)
+		}
+	}
+}
+
+func Test_packageScopedCVERiskAcceptance(t *testing.T) {
+	newGrypeReport := func() *artifacts.GrypeReportMin {
+		return &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+			{
+				Artifact:      artifacts.GrypeArtifact{Name: "libssl1.1", Version: "1.1.1n-0+deb11u4", Type: "deb", Purl: "pkg:deb/debian/libssl1.1@1.1.1n-0+deb11u4?arch=amd64"},
+				Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Critical"},
+			},
+			{
+				Artifact:      artifacts.GrypeArtifact{Name: "openssl", Version: "1.1.1n-0+deb11u4", Type: "deb", Purl: "pkg:deb/debian/openssl@1.1.1n-0+deb11u4?arch=amd64"},
+				Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Critical"},
+			},
+		}}
+	}
+
+	testTable := []struct {
+		label     string
+		cve       configCVE
+		wantCount int
+	}{
+		{label: "unscoped", cve: configCVE{ID: "CVE-1"}, wantCount: 0},
+		{label: "package", cve: configCVE{ID: "CVE-1", Package: "libssl1.1"}, wantCount: 1},
+		{label: "purl", cve: configCVE{ID: "CVE-1", Purl: "pkg:deb/debian/openssl"}, wantCount: 1},
+		{label: "version-range", cve: configCVE{ID: "CVE-1", VersionRange: ">=1.1.1, <1.1.2"}, wantCount: 0},
+		{label: "version-range-excluded", cve: configCVE{ID: "CVE-1", Package: "openssl", VersionRange: "<1.1.1"}, wantCount: 2},
+		{label: "artifact-type", cve: configCVE{ID: "CVE-1", ArtifactType: "npm"}, wantCount: 2},
+	}
+
+	for _, testCase := range testTable {
+		t.Run("grype-"+testCase.label, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Grype.CVERiskAcceptance.Enabled = true
+			config.Grype.CVERiskAcceptance.CVEs = []configCVE{testCase.cve}
+			report := newGrypeReport()
+
+			findings := allowedFindings(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}, ruleCVEAllow)
+			if len(findings) != testCase.wantCount {
+				t.Fatalf("want: %d got: %d", testCase.wantCount, len(findings))
+			}
+		})
+	}
+
+	t.Run("cyclonedx-every-affected-package", func(t *testing.T) {
+		report := &artifacts.CyclonedxReportMin{
+			Components: []artifacts.CyclonedxComponent{
+				{BOMRef: "ref-1", Name: "log4j-core", Version: "2.14.1", Purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+			},
+			Vulnerabilities: []artifacts.CyclonedxVulnerability{
+				{ID: "CVE-2021-44228", Affects: []artifacts.CyclondexAffectedPackage{{Ref: "ref-1"}}, Ratings: []artifacts.CyclonedxRating{{Severity: "critical"}}},
+				{ID: "CVE-2021-44228", Affects: []artifacts.CyclondexAffectedPackage{{Ref: "ref-1"}, {Ref: "pkg:maven/org.example/vendored@1.0.0"}}, Ratings: []artifacts.CyclonedxRating{{Severity: "critical"}}},
+			},
+		}
+		config := NewDefaultConfig()
+		config.Cyclonedx.SeverityLimit.Critical.Enabled = true
+		config.Cyclonedx.SeverityLimit.Critical.Limit = 0
+		config.Cyclonedx.CVERiskAcceptance.Enabled = true
+		config.Cyclonedx.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-2021-44228", Purl: "pkg:maven/org.apache.logging.log4j/log4j-core"}}
+
+		_, err := validateCyclonedxRules(DefaultValidators().Cyclonedx, config, report, nil, nil)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+		findings := allowedFindings(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}, ruleCVEAllow)
+		if len(findings) != 1 || len(findings[0].Packages) != 2 {
+			t.Fatalf("want the vulnerability with the vendored package, got: %+v", findings)
+		}
+	})
+
+	t.Run("trivy-package-scope", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Trivy.CVERiskAcceptance.Enabled = true
+		config.Trivy.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-1", Package: "openssl", VersionRange: "<3.0.0"}}
+		report := &artifacts.TrivyReportMin{Results: []artifacts.TrivyResult{
+			{Type: "debian", Vulnerabilities: []artifacts.TrivyVulnerability{
+				{VulnerabilityID: "CVE-1", PkgName: "openssl", InstalledVersion: "1.1.1n", Severity: "CRITICAL"},
+				{VulnerabilityID: "CVE-1", PkgName: "openssl", InstalledVersion: "3.0.2", Severity: "CRITICAL"},
+				{VulnerabilityID: "CVE-1", PkgName: "libssl1.1", InstalledVersion: "1.1.1n", Severity: "CRITICAL"},
+			}},
+		}}
+
+		findings := allowedFindings(report.NormalizedFindings(), CVERuleConfig{Artifact: "trivy", Config: config.Trivy}, ruleCVEAllow)
+		if len(findings) != 2 || findings[0].Packages[0].Version != "3.0.2" || findings[1].Packages[0].Name != "libssl1.1" {
+			t.Fatalf("want only openssl before 3.0.0 accepted, got: %+v", findings)
+		}
+	})
+
+	t.Run("osv-package-scope", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Osv.CVERiskAcceptance.Enabled = true
+		config.Osv.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-1", Package: "lodash", ArtifactType: "npm"}}
+		report := &artifacts.OsvReportMin{Results: []artifacts.OsvResult{{Packages: []artifacts.OsvPackageResult{
+			{Package: artifacts.OsvPackage{Name: "lodash", Version: "4.17.20", Ecosystem: "npm"}, Vulnerabilities: []artifacts.OsvVulnerability{{ID: "GHSA-1", Aliases: []string{"CVE-1"}}}},
+			{Package: artifacts.OsvPackage{Name: "lodash", Version: "4.17.20", Ecosystem: "PyPI"}, Vulnerabilities: []artifacts.OsvVulnerability{{ID: "GHSA-1", Aliases: []string{"CVE-1"}}}},
+		}}}}
+
+		findings := allowedFindings(report.NormalizedFindings(), CVERuleConfig{Artifact: "osv", Config: config.Osv}, ruleCVEAllow)
+		if len(findings) != 1 || findings[0].Packages[0].Type != "PyPI" {
+			t.Fatalf("want only the npm package accepted, got: %+v", findings)
+		}
+	})
+}
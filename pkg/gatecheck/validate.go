@@ -2,18 +2,22 @@ package gatecheck
 
 import (
 	"bytes"
+	"cmp"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"path"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/gatecheckdev/gatecheck/pkg/archive"
 	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
 	"github.com/gatecheckdev/gatecheck/pkg/epss"
 	"github.com/gatecheckdev/gatecheck/pkg/kev"
+	"github.com/gatecheckdev/gatecheck/pkg/validate"
 )
 
 var ErrValidationFailure = errors.New("Validation Failure")
@@ -23,496 +27,1429 @@ func newValidationErr(details string) error {
 }
 
 // Validate against config thresholds
+//
+// The report type is detected from the content unless an explicit
+// input type is provided with WithInputType
 func Validate(config *Config, reportSrc io.Reader, targetfilename string, optionFuncs ...optionFunc) error {
 	options := defaultOptions()
 	for _, f := range optionFuncs {
 		f(options)
 	}
 
-	switch {
-	case strings.Contains(targetfilename, "grype"):
-		slog.Debug("validate grype report", "filename", targetfilename)
-		return validateGrypeReportWithFetch(reportSrc, config, options)
+	if err := checkConfig(config); err != nil {
+		slog.Error("invalid configuration", "error", err)
+		return err
+	}
 
-	case strings.Contains(targetfilename, "cyclonedx"):
-		slog.Debug("validate", "filename", targetfilename, "filetype", "cyclonedx")
-		return validateCyclonedxReportWithFetch(reportSrc, config, options)
+	content, reportType, err := readAndResolveReportType(reportSrc, options.inputType)
+	if err != nil {
+		slog.Error("cannot determine report type", "filename", targetfilename, "error", err)
+		return errors.New("Failed to validate artifact. See log for details.")
+	}
 
-	case strings.Contains(targetfilename, "semgrep"):
-		slog.Debug("validate", "filename", targetfilename, "filetype", "semgrep")
-		return validateSemgrepReport(reportSrc, config)
+	slog.Debug("validate", "filename", targetfilename, "filetype", reportType)
 
-	case strings.Contains(targetfilename, "gitleaks"):
-		slog.Debug("validate", "filename", targetfilename, "filetype", "gitleaks")
-		return validateGitleaksReport(reportSrc, config)
+	if options.sarifOutput != nil {
+		return validateWithSarifOutput(options.sarifOutput, content, reportType, config, options)
+	}
 
-	case strings.Contains(targetfilename, "syft"):
-		slog.Debug("validate", "filename", targetfilename, "filetype", "syft")
-		return errors.New("Syft validation not supported yet.")
+	src := bytes.NewReader(content)
+
+	switch reportType {
+	case ReportTypeGrype:
+		return validateGrypeReportWithFetch(src, config, options)
+	case ReportTypeCyclonedx:
+		return validateCyclonedxReportWithFetch(src, config, options)
+	case ReportTypeSemgrep:
+		return validateSemgrepReport(src, config, options.validators)
+	case ReportTypeGitleaks:
+		return validateGitleaksReport(src, config, options.validators)
+	case ReportTypeTrufflehog:
+		return validateTrufflehogReport(src, config, options.validators)
+	case ReportTypeSyft:
+		return validateSyftReport(src, config, options.validators)
+	case ReportTypeTrivy:
+		return validateTrivyReportWithFetch(src, config, options)
+	case ReportTypeOsv:
+		return validateOsvReportWithFetch(src, config, options)
+	case ReportTypeSarif:
+		return validateSarifReport(src, config, options.validators)
+	case ReportTypeSpdx:
+		return validateSpdxReport(src, config, options.validators)
+	case ReportTypeGovulncheck:
+		return validateGovulncheckReport(src, config, options.validators)
+	case ReportTypeZap:
+		return validateZapReport(src, config, options.validators)
+	case ReportTypeCheckov:
+		return validateCheckovReport(src, config, options.validators)
+	case ReportTypeTfsec:
+		return validateTfsecReport(src, config, options.validators)
+	case ReportTypeBundle:
+		return validateBundle(src, config, options)
+	}
 
-	case strings.Contains(targetfilename, "bundle"):
-		slog.Debug("validate", "filename", targetfilename, "filetype", "bundle")
-		return validateBundle(reportSrc, config, options)
+	slog.Error("unsupported file type", "filename", targetfilename, "filetype", reportType)
+	return errors.New("Failed to validate artifact. See log for details.")
+}
 
-	default:
-		slog.Error("unsupported file type, cannot be determined from filename", "filename", targetfilename)
-		return errors.New("Failed to validate artifact. See log for details.")
-	}
+// failedRuleErrs a failed rule error naming each object
+func failedRuleErrs[E any](rule string, objects []E, id func(E) string) error {
+	return validate.DenyFunc(objects, func(object E) error {
+		return validate.NewFailedRuleError(rule, id(object))
+	})
+}
+
+// newFailedRulesErr a validation failure wrapping the failed rule errors of a validator
+func newFailedRulesErr(label string, errs error) error {
+	return fmt.Errorf("%w: %s: %w", ErrValidationFailure, label, errs)
 }
 
-func ruleGrypeSeverityLimit(config *Config, report *artifacts.GrypeReportMin) bool {
-	validationPass := true
+// Severity Limits
+//
+// Every report type with a severity scale is limited by the same rule against its normalized findings,
+// a report type only maps its config to the limit of each severity
 
-	limits := map[string]configLimit{
-		"critical": config.Grype.SeverityLimit.Critical,
-		"high":     config.Grype.SeverityLimit.High,
-		"medium":   config.Grype.SeverityLimit.Medium,
-		"low":      config.Grype.SeverityLimit.Low,
-	}
+// severityLimit the limit of a single severity on the tool's scale
+type severityLimit struct {
+	severity string
+	limit    configLimit
+}
+
+// severityLimits the limits of a report type
+type severityLimits struct {
+	// artifact the report type config key used in logs, for example "sarif"
+	artifact string
+	// rule the config key each severity is appended to, for example "sarif.levelLimit"
+	rule string
+	// limits highest severity first
+	limits []severityLimit
+}
 
-	for _, severity := range []string{"critical", "high", "medium", "low"} {
+// ruleSeverityLimit the count of findings at each severity can't exceed the enabled limit
+//
+// Findings with a severity that has no limit, including unknown, are never counted
+func ruleSeverityLimit(findings []artifacts.Finding, config severityLimits) error {
+	var errs error
 
-		configuredLimit := limits[severity]
-		matches := report.SelectBySeverity(severity)
+	for _, level := range config.limits {
+		matches := slices.DeleteFunc(slices.Clone(findings), func(finding artifacts.Finding) bool {
+			return finding.Severity != level.severity
+		})
 		matchCount := len(matches)
-		if !configuredLimit.Enabled {
-			slog.Debug("severity limit not enabled", "artifact", "grype", "severity", severity, "reported", matchCount)
+		if !level.limit.Enabled {
+			slog.Debug("severity limit not enabled", "artifact", config.artifact, "rule", config.rule, "severity", level.severity, "reported", matchCount)
 			continue
 		}
-		if matchCount > int(configuredLimit.Limit) {
-			slog.Error("grype severity limit exceeded", "severity", severity, "report", matchCount, "limit", configuredLimit.Limit)
-			validationPass = false
+		if matchCount > int(level.limit.Limit) {
+			slog.Error("severity limit exceeded", "artifact", config.artifact, "rule", config.rule, "severity", level.severity, "report", matchCount, "limit", level.limit.Limit)
+			errs = errors.Join(errs, failedRuleErrs(config.rule+"."+level.severity, matches, findingID))
 			continue
 		}
-		slog.Info("severity limit valid", "artifact", "grype", "severity", severity, "reported", matchCount, "limit", configuredLimit.Limit)
+		slog.Info("severity limit valid", "artifact", config.artifact, "rule", config.rule, "severity", level.severity, "reported", matchCount, "limit", level.limit.Limit)
+	}
+
+	enabled := slices.ContainsFunc(config.limits, func(level severityLimit) bool { return level.limit.Enabled })
+	unknown := slices.DeleteFunc(slices.Clone(findings), func(finding artifacts.Finding) bool {
+		return finding.Severity != artifacts.FindingSeverityUnknown
+	})
+	if enabled && len(unknown) > 0 {
+		slog.Warn("findings without a rated severity are not limited", "artifact", config.artifact, "rule", config.rule, "reported", len(unknown))
+	}
+
+	return errs
+}
+
+// severityLimitRule the severity limit as a validation rule for the objects of a report, finding normalizes each object
+func severityLimitRule[ObjectT any, ConfigT any](finding func(*ObjectT) artifacts.Finding, limits func(ConfigT) severityLimits) func([]ObjectT, ConfigT) error {
+	return func(objects []ObjectT, config ConfigT) error {
+		findings := make([]artifacts.Finding, 0, len(objects))
+		for i := range objects {
+			findings = append(findings, finding(&objects[i]))
+		}
+		return ruleSeverityLimit(findings, limits(config))
+	}
+}
+
+// CVE Rules
+//
+// Grype, CycloneDX, Trivy and OSV-Scanner share a config shape and are validated by the same rules
+// against the report's normalized findings
+
+// CVERuleConfig the config section and external data passed to every CVE rule
+type CVERuleConfig struct {
+	// Artifact the report type config key, for example "grype", used in logs and failed rule names
+	Artifact string
+	Config   ReportWithCVEs
+	Catalog  *kev.Catalog
+	EPSSData *epss.Data
+}
+
+// rule the config key of a rule in the report type section, for example "grype.cveLimit"
+func (c CVERuleConfig) rule(key string) string {
+	return c.Artifact + "." + key
+}
+
+func findingID(finding artifacts.Finding) string {
+	return finding.Key()
+}
+
+// ruleCVESeverityLimit the shared severity limit, only findings with a fix are counted when onlyFixable is set
+func ruleCVESeverityLimit(findings []artifacts.Finding, config CVERuleConfig) error {
+	if config.Config.SeverityLimit.OnlyFixable {
+		findings = slices.DeleteFunc(slices.Clone(findings), func(finding artifacts.Finding) bool {
+			return finding.FixState != artifacts.FixStateFixed
+		})
+	}
+	return ruleSeverityLimit(findings, cveSeverityLimits(config))
+}
+
+func cveSeverityLimits(config CVERuleConfig) severityLimits {
+	limit := config.Config.SeverityLimit
+	return severityLimits{artifact: config.Artifact, rule: config.rule("severityLimit"), limits: []severityLimit{
+		{"critical", limit.Critical},
+		{"high", limit.High},
+		{"medium", limit.Medium},
+		{"low", limit.Low},
+	}}
+}
+
+// ruleCVEDeny the finding ID or any alias is denied
+func ruleCVEDeny(findings []artifacts.Finding, config CVERuleConfig) error {
+	limit := config.Config.CVELimit
+	if !limit.Enabled {
+		slog.Debug("cve id limits not enabled", "artifact", config.Artifact, "count_denied", len(limit.CVEs))
+		return nil
+	}
+	return validate.DenyFunc(findings, func(finding artifacts.Finding) error {
+		i := slices.IndexFunc(limit.CVEs, func(cve configCVE) bool {
+			return finding.HasID(cve.ID)
+		})
+		if i < 0 {
+			return nil
+		}
+		slog.Error("cve matched to Deny List", "artifact", config.Artifact, "id", limit.CVEs[i].ID, "metadata", fmt.Sprintf("%+v", limit.CVEs[i]))
+		return validate.NewFailedRuleError(config.rule("cveLimit"), findingID(finding))
+	})
+}
+
+// ruleCVEAcceptanceExpired an expired risk acceptance matches a finding
+func ruleCVEAcceptanceExpired(findings []artifacts.Finding, config CVERuleConfig) error {
+	reported := func(cve configCVE) []string {
+		ids := []string{}
+		for _, finding := range findings {
+			if cveAccepts(cve, finding) {
+				ids = append(ids, findingID(finding))
+			}
+		}
+		return ids
+	}
+	return ruleCVERiskAcceptanceExpired(config.Artifact, config.Config.CVERiskAcceptance, reported)
+}
+
+// cveAccepts the finding ID or any alias matches and the entry covers every package of the finding
+func cveAccepts(cve configCVE, finding artifacts.Finding) bool {
+	return finding.HasID(cve.ID) && cveCoversPackages(cve, finding.Packages)
+}
+
+func ruleCVEAllow(finding artifacts.Finding, config CVERuleConfig) bool {
+	if !config.Config.CVERiskAcceptance.Enabled {
+		return false
+	}
+
+	accepted := activeCVEs(config.Config.CVERiskAcceptance.CVEs)
+	i := slices.IndexFunc(accepted, func(cve configCVE) bool {
+		return cveAccepts(cve, finding)
+	})
+	if i < 0 {
+		return false
+	}
+	slog.Info("CVE explicitly allowed, removing from subsequent rules", "artifact", config.Artifact,
+		"id", finding.ID, "severity", finding.Severity, "packages", finding.PackagesShort(),
+		"reason", accepted[i].Reason, "owner", accepted[i].Owner, "ticket", accepted[i].Ticket)
+	return true
+}
+
+// ruleFixStateAllow vulnerabilities with an accepted fix state, for example wont-fix
+func ruleFixStateAllow(finding artifacts.Finding, config CVERuleConfig) bool {
+	acceptance := config.Config.FixStateRiskAcceptance
+	if !acceptance.Enabled || !fixStateAccepted(acceptance, finding.FixState) {
+		return false
+	}
+	slog.Info("fix state risk accepted, removing from subsequent rules", "artifact", config.Artifact,
+		"id", finding.ID, "severity", finding.Severity, "fix_state", finding.FixState)
+	return true
+}
+
+// epssOverLimit a limit without a percentile only checks the score and a limit without a score only checks
+// the percentile, with both the limit is exceeded if either value is over
+func epssOverLimit(limit configEPSSLimit, cve epss.CVE) bool {
+	overScore := cve.EPSSValue() > limit.Score
+	if limit.Percentile <= 0 {
+		return overScore
+	}
+	overPercentile := cve.PercentileValue() > limit.Percentile
+	if limit.Score <= 0 {
+		return overPercentile
+	}
+	return overScore || overPercentile
+}
+
+// epssRiskAccepted an acceptance without a percentile only checks the score and an acceptance without a score
+// only checks the percentile, with both the values must both be under
+func epssRiskAccepted(acceptance configEPSSRiskAcceptance, cve epss.CVE) bool {
+	underScore := acceptance.Score > cve.EPSSValue()
+	if acceptance.Percentile <= 0 {
+		return underScore
+	}
+	underPercentile := acceptance.Percentile > cve.PercentileValue()
+	if acceptance.Score <= 0 {
+		return underPercentile
+	}
+	return underScore && underPercentile
+}
+
+// ruleEPSSAllow the EPSS score is looked up by the finding's CVE, the ID or a CVE alias
+func ruleEPSSAllow(finding artifacts.Finding, config CVERuleConfig) bool {
+	if !config.Config.EPSSRiskAcceptance.Enabled || config.EPSSData == nil {
+		return false
+	}
+	epssCVE, ok := config.EPSSData.CVEs[finding.CVE()]
+	if !ok {
+		slog.Debug("no epss score", "id", finding.ID, "cve_id", finding.CVE(), "severity", finding.Severity)
+		return false
+	}
+	if !epssRiskAccepted(config.Config.EPSSRiskAcceptance, epssCVE) {
+		return false
+	}
+	slog.Info(
+		"risk accepted reason: epss score",
+		"artifact", config.Artifact,
+		"id", finding.ID,
+		"cve_id", finding.CVE(),
+		"severity", finding.Severity,
+		"epss_score", epssCVE.EPSS,
+		"epss_percentile", epssCVE.Percentile,
+	)
+	return true
+}
+
+func ruleEPSSLimit(findings []artifacts.Finding, config CVERuleConfig) error {
+	limit := config.Config.EPSSLimit
+	if !limit.Enabled {
+		slog.Debug("epss limit not enabled", "artifact", config.Artifact)
+		return nil
+	}
+	if config.EPSSData == nil {
+		slog.Error("epss allowance enabled but no data exists", "artifact", config.Artifact)
+		return validate.NewFailedRuleError(config.rule("epssLimit"), "no epss data")
+	}
+
+	slog.Debug("run epss limit rule",
+		"artifact", config.Artifact,
+		"vulnerabilities", len(findings),
+		"epss_limit_score", limit.Score,
+		"epss_limit_percentile", limit.Percentile,
+	)
+
+	// the score or percentile is higher than the limit
+	badFindings := slices.DeleteFunc(slices.Clone(findings), func(finding artifacts.Finding) bool {
+		epssCVE, ok := config.EPSSData.CVEs[finding.CVE()]
+		if !ok || !epssOverLimit(limit, epssCVE) {
+			return true
+		}
+		slog.Warn(
+			"epss score limit violation",
+			"artifact", config.Artifact,
+			"id", finding.ID,
+			"cve_id", finding.CVE(),
+			"severity", finding.Severity,
+			"epss_score", epssCVE.EPSS,
+			"epss_percentile", epssCVE.Percentile,
+		)
+		return false
+	})
+	if len(badFindings) > 0 {
+		slog.Error("cve(s) with epss scores over limit",
+			"artifact", config.Artifact,
+			"over_limit_cves", len(badFindings),
+			"epss_limit_score", limit.Score,
+			"epss_limit_percentile", limit.Percentile,
+		)
+		return failedRuleErrs(config.rule("epssLimit"), badFindings, findingID)
+	}
+	return nil
+}
+
+func ruleCVSSLimit(findings []artifacts.Finding, config CVERuleConfig) error {
+	limit := config.Config.CVSSLimit
+	if !limit.Enabled {
+		slog.Debug("cvss limit not enabled", "artifact", config.Artifact)
+		return nil
 	}
 
-	return validationPass
+	return validate.DenyFunc(findings, func(finding artifacts.Finding) error {
+		score, ok := preferredCVSS(finding.CVSS, limit.Versions)
+		if !ok {
+			slog.Debug("no cvss score", "artifact", config.Artifact, "id", finding.ID)
+			return nil
+		}
+		if score.Score <= limit.Score {
+			return nil
+		}
+		slog.Error("cvss score limit violation", "artifact", config.Artifact, "id", finding.ID,
+			"packages", finding.PackagesShort(), "cvss_score", score.Score, "cvss_version", score.Version,
+			"cvss_source", score.Source, "cvss_limit_score", limit.Score)
+		return validate.NewFailedRuleError(config.rule("cvssLimit"), findingID(finding))
+	})
 }
 
-func ruleCyclonedxSeverityLimit(config *Config, report *artifacts.CyclonedxReportMin) bool {
-	validationPass := true
+// preferredCVSS the highest score of the first preferred version with a score,
+// unlisted versions are used newest first if no preferred version has one
+func preferredCVSS(scores []artifacts.CVSS, versions []string) (artifacts.CVSS, bool) {
+	if len(scores) == 0 {
+		return artifacts.CVSS{}, false
+	}
+
+	highestOf := func(version string) (artifacts.CVSS, bool) {
+		best, found := artifacts.CVSS{}, false
+		for _, score := range scores {
+			if score.Version == version && (!found || score.Score > best.Score) {
+				best, found = score, true
+			}
+		}
+		return best, found
+	}
+
+	for _, version := range versions {
+		if score, ok := highestOf(strings.TrimSpace(version)); ok {
+			return score, true
+		}
+	}
+
+	remaining := slices.Clone(scores)
+	slices.SortStableFunc(remaining, func(a, b artifacts.CVSS) int {
+		if c := compareVersions(b.Version, a.Version); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Score, a.Score)
+	})
+	return remaining[0], true
+}
+
+func govulncheckID(vulnerability artifacts.GovulncheckVulnerability) string {
+	return findingID(vulnerability.Finding())
+}
+
+func ruleGovulncheckCVEDeny(vulnerabilities []artifacts.GovulncheckVulnerability, config *Config) error {
+	if !config.Govulncheck.CVELimit.Enabled {
+		slog.Debug("cve id limits not enabled", "artifact", "govulncheck", "count_denied", len(config.Govulncheck.CVELimit.CVEs))
+		return nil
+	}
+	return validate.DenyFunc(vulnerabilities, func(vulnerability artifacts.GovulncheckVulnerability) error {
+		if !govulncheckMatches(config.Govulncheck.CVELimit.CVEs, vulnerability) {
+			return nil
+		}
+		if vulnerability.Level != artifacts.GovulncheckLevelCalled {
+			slog.Info("cve matched to deny list but not called, informational", "artifact", "govulncheck",
+				"id", vulnerability.OSV.ID, "level", vulnerability.Level, "module", vulnerability.Module)
+			return nil
+		}
+		slog.Error("cve matched to deny list", "artifact", "govulncheck",
+			"id", vulnerability.OSV.ID, "cve_id", vulnerability.OSV.CVE(), "symbol", vulnerability.Symbol, "position", vulnerability.Call.PositionShort())
+		return validate.NewFailedRuleError("govulncheck.cveLimit", govulncheckID(vulnerability))
+	})
+}
+
+// ruleGovulncheckAcceptanceExpired an expired risk acceptance matches a called vulnerability
+func ruleGovulncheckAcceptanceExpired(vulnerabilities []artifacts.GovulncheckVulnerability, config *Config) error {
+	reported := func(cve configCVE) []string {
+		ids := []string{}
+		for _, vulnerability := range vulnerabilities {
+			if vulnerability.Level == artifacts.GovulncheckLevelCalled && vulnerability.OSV.HasID(cve.ID) {
+				ids = append(ids, govulncheckID(vulnerability))
+			}
+		}
+		return ids
+	}
+	return ruleCVERiskAcceptanceExpired("govulncheck", config.Govulncheck.CVERiskAcceptance, reported)
+}
+
+func ruleGovulncheckCVEAllow(vulnerability artifacts.GovulncheckVulnerability, config *Config) bool {
+	if !config.Govulncheck.CVERiskAcceptance.Enabled {
+		return false
+	}
+
+	accepted := activeCVEs(config.Govulncheck.CVERiskAcceptance.CVEs)
+	// govulncheck doesn't support package scope, scoped entries never match
+	i := slices.IndexFunc(accepted, func(cve configCVE) bool {
+		return vulnerability.OSV.HasID(cve.ID) && !cveScoped(cve)
+	})
+	if i < 0 {
+		return false
+	}
+	slog.Info("CVE explicitly allowed, removing from subsequent rules",
+		"id", vulnerability.OSV.ID, "cve_id", vulnerability.OSV.CVE(), "level", vulnerability.Level,
+		"reason", accepted[i].Reason, "owner", accepted[i].Owner, "ticket", accepted[i].Ticket)
+	return true
+}
+
+// govulncheckMatches the OSV ID or any alias is in the list
+func govulncheckMatches(cves []configCVE, vulnerability artifacts.GovulncheckVulnerability) bool {
+	return slices.ContainsFunc(cves, func(cve configCVE) bool {
+		return vulnerability.OSV.HasID(cve.ID)
+	})
+}
+
+// ruleGovulncheckCalledLimit imported and required vulnerabilities are logged, only called vulnerabilities are counted
+func ruleGovulncheckCalledLimit(vulnerabilities []artifacts.GovulncheckVulnerability, config *Config) error {
+	atLevel := func(level string) []artifacts.GovulncheckVulnerability {
+		return slices.DeleteFunc(slices.Clone(vulnerabilities), func(vulnerability artifacts.GovulncheckVulnerability) bool {
+			return vulnerability.Level != level
+		})
+	}
+
+	for _, level := range []string{artifacts.GovulncheckLevelImported, artifacts.GovulncheckLevelRequired} {
+		for _, vulnerability := range atLevel(level) {
+			slog.Info("vulnerable code not called, informational", "artifact", "govulncheck",
+				"id", vulnerability.OSV.ID, "level", level, "module", vulnerability.Module, "version", vulnerability.Version)
+		}
+	}
+
+	called := atLevel(artifacts.GovulncheckLevelCalled)
+	configuredLimit := config.Govulncheck.CalledLimit
+	if !configuredLimit.Enabled {
+		slog.Debug("called limit not enabled", "artifact", "govulncheck", "reported", len(called))
+		return nil
+	}
+
+	for _, vulnerability := range called {
+		slog.Warn("vulnerable function called", "artifact", "govulncheck",
+			"id", vulnerability.OSV.ID, "cve_id", vulnerability.OSV.CVE(), "module", vulnerability.Module,
+			"symbol", vulnerability.Symbol, "position", vulnerability.Call.PositionShort(),
+			"fixed_version", vulnerability.FixedVersion)
+	}
+
+	if len(called) > int(configuredLimit.Limit) {
+		slog.Error("called limit exceeded", "artifact", "govulncheck", "reported", len(called), "limit", configuredLimit.Limit)
+		return failedRuleErrs("govulncheck.calledLimit", called, govulncheckID)
+	}
+	slog.Info("called limit valid", "artifact", "govulncheck", "reported", len(called), "limit", configuredLimit.Limit)
+	return nil
+}
+
+// semgrepErrorID the error type and path
+func semgrepErrorID(semgrepError artifacts.SemgrepError) string {
+	if semgrepError.Path == "" {
+		return semgrepError.TypeName()
+	}
+	return semgrepError.TypeName() + " " + semgrepError.Path
+}
+
+// SemgrepRuleConfig the config and the report's scan errors passed to every Semgrep rule
+type SemgrepRuleConfig struct {
+	*Config
+	Errors []artifacts.SemgrepError
+}
+
+// ruleSemgrepErrorPolicy scan errors aren't results, the policy reads them from the rule config
+// and runs in the first Semgrep validator
+func ruleSemgrepErrorPolicy(_ []artifacts.SemgrepResults, config SemgrepRuleConfig) error {
+	semgrepErrors := config.Errors
+	policy := config.Semgrep.ErrorPolicy
+	slog.Debug(
+		"error policy rule", "artifact", "semgrep", "reported", len(semgrepErrors),
+		"error_limit_enabled", policy.ErrorLimit.Enabled,
+		"fail_levels", policy.FailLevels, "fail_types", policy.FailTypes, "ignore_paths", policy.IgnorePaths,
+	)
+
+	errs := slices.DeleteFunc(slices.Clone(semgrepErrors), func(semgrepError artifacts.SemgrepError) bool {
+		ignored := semgrepError.Path != "" && slices.ContainsFunc(policy.IgnorePaths, func(pattern string) bool {
+			return globMatch(pattern, semgrepError.Path)
+		})
+		if ignored {
+			slog.Debug("scan error ignored by path", "artifact", "semgrep", "type", semgrepError.TypeName(), "path", semgrepError.Path)
+		}
+		return ignored
+	})
+
+	failedErrs := validate.DenyFunc(errs, func(semgrepError artifacts.SemgrepError) error {
+		failLevel := slices.ContainsFunc(policy.FailLevels, func(level string) bool {
+			return strings.EqualFold(level, semgrepError.Level)
+		})
+		failType := slices.ContainsFunc(policy.FailTypes, func(errorType string) bool {
+			return strings.EqualFold(errorType, semgrepError.TypeName())
+		})
+		if !failLevel && !failType {
+			return nil
+		}
+		slog.Error("scan error matched to error policy", "artifact", "semgrep", "level", semgrepError.Level,
+			"type", semgrepError.TypeName(), "path", semgrepError.Path, "message", semgrepError.ShortMessage())
+		return validate.NewFailedRuleError("semgrep.errorPolicy", semgrepErrorID(semgrepError))
+	})
+
+	if !policy.ErrorLimit.Enabled {
+		slog.Debug("error limit not enabled", "artifact", "semgrep", "reported", len(errs))
+		return failedErrs
+	}
+	if len(errs) > int(policy.ErrorLimit.Limit) {
+		for _, semgrepError := range errs {
+			slog.Error("scan error", "artifact", "semgrep", "level", semgrepError.Level,
+				"type", semgrepError.TypeName(), "path", semgrepError.Path, "message", semgrepError.ShortMessage())
+		}
+		slog.Error("error limit exceeded", "artifact", "semgrep", "report", len(errs), "limit", policy.ErrorLimit.Limit)
+		return errors.Join(failedErrs, failedRuleErrs("semgrep.errorPolicy.errorLimit", errs, semgrepErrorID))
+	}
+	slog.Info("error limit valid", "artifact", "semgrep", "reported", len(errs), "limit", policy.ErrorLimit.Limit)
+	return failedErrs
+}
+
+func semgrepResultID(result artifacts.SemgrepResults) string {
+	return findingID(result.Finding())
+}
+
+func semgrepSeverityLimits(config SemgrepRuleConfig) severityLimits {
+	limit := config.Semgrep.SeverityLimit
+	return severityLimits{artifact: "semgrep", rule: "semgrep.severityLimit", limits: []severityLimit{
+		{"error", limit.Error},
+		{"warning", limit.Warning},
+		{"info", limit.Info},
+	}}
+}
+
+func ruleSemgrepImpactRiskAccept(result artifacts.SemgrepResults, config SemgrepRuleConfig) bool {
+	if !semgrepImpactAccepted(config.Config, result) {
+		return false
+	}
+	slog.Info(
+		"risk accepted: impact is configured for risk acceptance", "artifact", "semgrep",
+		"check_id", result.CheckID,
+		"severity", result.Extra.Severity,
+		"impact", result.Extra.Metadata.Impact,
+	)
+	return true
+}
+
+// semgrepImpactAccepted the result impact is configured for risk acceptance
+func semgrepImpactAccepted(config *Config, result artifacts.SemgrepResults) bool {
+	if !config.Semgrep.ImpactRiskAcceptance.Enabled {
+		return false
+	}
+	switch {
+	case config.Semgrep.ImpactRiskAcceptance.High && strings.EqualFold(result.Extra.Metadata.Impact, "high"):
+		return true
+	case config.Semgrep.ImpactRiskAcceptance.Medium && strings.EqualFold(result.Extra.Metadata.Impact, "medium"):
+		return true
+	case config.Semgrep.ImpactRiskAcceptance.Low && strings.EqualFold(result.Extra.Metadata.Impact, "low"):
+		return true
+	}
+	return false
+}
+
+func ruleSemgrepRiskAccept(result artifacts.SemgrepResults, config SemgrepRuleConfig) bool {
+	if !semgrepRiskAccepted(config.Config, result) {
+		return false
+	}
+	matrix := config.Semgrep.RiskMatrix
+	slog.Info(
+		"risk accepted: computed risk is configured for risk acceptance", "artifact", "semgrep",
+		"check_id", result.CheckID,
+		"risk", semgrepRisk(matrix, result.Extra.Metadata),
+		"impact", result.Extra.Metadata.Impact,
+		"likelihood", result.Extra.Metadata.Likelihood,
+		"confidence", result.Extra.Metadata.Confidence,
+	)
+	return true
+}
+
+// semgrepRiskAccepted the computed risk of the result is configured for risk acceptance
+func semgrepRiskAccepted(config *Config, result artifacts.SemgrepResults) bool {
+	matrix := config.Semgrep.RiskMatrix
+	if !matrix.Enabled || !matrix.RiskAcceptance.Enabled {
+		return false
+	}
+	switch semgrepRisk(matrix, result.Extra.Metadata) {
+	case "high":
+		return matrix.RiskAcceptance.High
+	case "medium":
+		return matrix.RiskAcceptance.Medium
+	case "low":
+		return matrix.RiskAcceptance.Low
+	}
+	return false
+}
+
+func ruleSemgrepRiskLimit(results []artifacts.SemgrepResults, config SemgrepRuleConfig) error {
+	matrix := config.Semgrep.RiskMatrix
+	if !matrix.Enabled {
+		slog.Debug("risk matrix not enabled", "artifact", "semgrep")
+		return nil
+	}
+
+	var errs error
 
 	limits := map[string]configLimit{
-		"critical": config.Cyclonedx.SeverityLimit.Critical,
-		"high":     config.Cyclonedx.SeverityLimit.High,
-		"medium":   config.Cyclonedx.SeverityLimit.Medium,
-		"low":      config.Cyclonedx.SeverityLimit.Low,
+		"critical": matrix.RiskLimit.Critical,
+		"high":     matrix.RiskLimit.High,
+		"medium":   matrix.RiskLimit.Medium,
+		"low":      matrix.RiskLimit.Low,
+	}
+	byRisk := map[string][]artifacts.SemgrepResults{}
+	for _, result := range results {
+		risk := semgrepRisk(matrix, result.Extra.Metadata)
+		byRisk[risk] = append(byRisk[risk], result)
 	}
 
-	for _, severity := range []string{"critical", "high", "medium", "low"} {
+	for _, risk := range []string{"critical", "high", "medium", "low"} {
 
-		configuredLimit := limits[severity]
-		vulnerabilities := report.SelectBySeverity(severity)
-		matchCount := len(vulnerabilities)
+		configuredLimit := limits[risk]
+		matchCount := len(byRisk[risk])
 		if !configuredLimit.Enabled {
-			slog.Debug("severity limit not enabled", "artifact", "cyclonedx", "severity", severity, "reported", matchCount)
+			slog.Debug("computed risk limit not enabled", "artifact", "semgrep", "risk", risk, "reported", matchCount)
 			continue
 		}
 		if matchCount > int(configuredLimit.Limit) {
-			slog.Error("severity limit exceeded", "artifact", "cyclonedx", "severity", severity, "report", matchCount, "limit", configuredLimit.Limit)
-			validationPass = false
+			slog.Error("computed risk limit exceeded", "artifact", "semgrep", "risk", risk, "report", matchCount, "limit", configuredLimit.Limit)
+			errs = errors.Join(errs, failedRuleErrs("semgrep.riskMatrix.riskLimit."+risk, byRisk[risk], semgrepResultID))
 			continue
 		}
-		slog.Info("severity limit valid", "artifact", "cyclonedx", "severity", severity, "reported", matchCount, "limit", configuredLimit.Limit)
+		slog.Info("computed risk limit valid", "artifact", "semgrep", "risk", risk, "reported", matchCount, "limit", configuredLimit.Limit)
 	}
+	slog.Debug("computed risk unknown", "artifact", "semgrep", "reported", len(byRisk["unknown"]))
 
-	return validationPass
+	return errs
 }
 
-func ruleGrypeCVEDeny(config *Config, report *artifacts.GrypeReportMin) bool {
-	if !config.Grype.CVELimit.Enabled {
-		slog.Debug("cve id limits not enabled", "artifact", "grype", "count_denied", len(config.Grype.CVELimit.CVEs))
-		return true
+// semgrepRisk the computed risk level: critical, high, medium, low or unknown
+func semgrepRisk(matrix configSemgrepRiskMatrix, metadata artifacts.SemgrepMetadata) string {
+	weight := func(level string) uint {
+		switch strings.ToLower(strings.TrimSpace(level)) {
+		case "high":
+			return matrix.Weights.High
+		case "medium":
+			return matrix.Weights.Medium
+		case "low":
+			return matrix.Weights.Low
+		}
+		return 0
 	}
-	for _, cve := range config.Grype.CVELimit.CVEs {
-		contains := slices.ContainsFunc(report.Matches, func(match artifacts.GrypeMatch) bool {
-			return strings.EqualFold(match.Vulnerability.ID, cve.ID)
-		})
 
-		if contains {
-			slog.Error("cve matched to Deny List", "artifact", "grype", "id", cve.ID, "metadata", fmt.Sprintf("%+v", cve))
-			return false
+	score := weight(metadata.Impact) * weight(metadata.Likelihood) * weight(metadata.Confidence)
+	switch {
+	case score == 0:
+		return "unknown"
+	case score >= matrix.Thresholds.Critical:
+		return "critical"
+	case score >= matrix.Thresholds.High:
+		return "high"
+	case score >= matrix.Thresholds.Medium:
+		return "medium"
+	}
+	return "low"
+}
+
+func ruleSemgrepRuleIDDeny(results []artifacts.SemgrepResults, config SemgrepRuleConfig) error {
+	if !config.Semgrep.RuleIDLimit.Enabled {
+		slog.Debug("rule id limits not enabled", "artifact", "semgrep", "count_denied", len(config.Semgrep.RuleIDLimit.RuleIDs))
+		return nil
+	}
+	return validate.DenyFunc(results, func(result artifacts.SemgrepResults) error {
+		ruleID, ok := semgrepRuleIDMatch(config.Semgrep.RuleIDLimit.RuleIDs, result.CheckID)
+		if !ok {
+			return nil
 		}
+		slog.Error("rule id matched to deny list", "artifact", "semgrep", "check_id", result.CheckID,
+			"path", result.Path, "line", result.Start.Line, "metadata", fmt.Sprintf("%+v", ruleID))
+		return validate.NewFailedRuleError("semgrep.ruleIdLimit", semgrepResultID(result))
+	})
+}
+
+func ruleSemgrepCWEDeny(results []artifacts.SemgrepResults, config SemgrepRuleConfig) error {
+	if !config.Semgrep.CWELimit.Enabled {
+		slog.Debug("cwe limits not enabled", "artifact", "semgrep", "count_denied", len(config.Semgrep.CWELimit.IDs))
+		return nil
 	}
+	return validate.DenyFunc(results, func(result artifacts.SemgrepResults) error {
+		cwe, ok := semgrepIDMatch(config.Semgrep.CWELimit.IDs, result.Extra.Metadata.CWEs())
+		if !ok {
+			return nil
+		}
+		slog.Error("cwe matched to deny list", "artifact", "semgrep", "cwe", cwe, "check_id", result.CheckID,
+			"path", result.Path, "line", result.Start.Line)
+		return validate.NewFailedRuleError("semgrep.cweLimit", semgrepResultID(result))
+	})
+}
+
+func ruleSemgrepOwaspDeny(results []artifacts.SemgrepResults, config SemgrepRuleConfig) error {
+	if !config.Semgrep.OwaspLimit.Enabled {
+		slog.Debug("owasp limits not enabled", "artifact", "semgrep", "count_denied", len(config.Semgrep.OwaspLimit.IDs))
+		return nil
+	}
+	return validate.DenyFunc(results, func(result artifacts.SemgrepResults) error {
+		owasp, ok := semgrepIDMatch(config.Semgrep.OwaspLimit.IDs, result.Extra.Metadata.OwaspCategories())
+		if !ok {
+			return nil
+		}
+		slog.Error("owasp category matched to deny list", "artifact", "semgrep", "owasp", owasp, "check_id", result.CheckID,
+			"path", result.Path, "line", result.Start.Line)
+		return validate.NewFailedRuleError("semgrep.owaspLimit", semgrepResultID(result))
+	})
+}
+
+func ruleSemgrepRuleIDAllow(result artifacts.SemgrepResults, config SemgrepRuleConfig) bool {
+	if !config.Semgrep.RuleIDRiskAcceptance.Enabled {
+		return false
+	}
+	if _, ok := semgrepRuleIDMatch(config.Semgrep.RuleIDRiskAcceptance.RuleIDs, result.CheckID); !ok {
+		return false
+	}
+	slog.Info("rule id explicitly allowed, removing from subsequent rules", "artifact", "semgrep",
+		"check_id", result.CheckID, "severity", result.Extra.Severity, "path", result.Path)
 	return true
 }
 
-func ruleCyclonedxCVEDeny(config *Config, report *artifacts.CyclonedxReportMin) bool {
-	if !config.Cyclonedx.CVELimit.Enabled {
-		slog.Debug("cve id limits not enabled", "artifact", "cyclonedx", "count_denied", len(config.Cyclonedx.CVELimit.CVEs))
-		return true
+func ruleSemgrepCWEAllow(result artifacts.SemgrepResults, config SemgrepRuleConfig) bool {
+	if !config.Semgrep.CWERiskAcceptance.Enabled {
+		return false
 	}
-	for _, cve := range config.Cyclonedx.CVELimit.CVEs {
-		contains := slices.ContainsFunc(report.Vulnerabilities, func(vulerability artifacts.CyclonedxVulnerability) bool {
-			return strings.EqualFold(vulerability.ID, cve.ID)
-		})
+	cwe, ok := semgrepIDMatch(config.Semgrep.CWERiskAcceptance.IDs, result.Extra.Metadata.CWEs())
+	if !ok {
+		return false
+	}
+	slog.Info("cwe explicitly allowed, removing from subsequent rules", "artifact", "semgrep",
+		"cwe", cwe, "check_id", result.CheckID, "severity", result.Extra.Severity, "path", result.Path)
+	return true
+}
 
-		if contains {
-			slog.Error("cve matched to Deny List", "artifact", "cyclonedx", "id", cve.ID, "metadata", fmt.Sprintf("%+v", cve))
-			return false
-		}
+func ruleSemgrepOwaspAllow(result artifacts.SemgrepResults, config SemgrepRuleConfig) bool {
+	if !config.Semgrep.OwaspRiskAcceptance.Enabled {
+		return false
+	}
+	owasp, ok := semgrepIDMatch(config.Semgrep.OwaspRiskAcceptance.IDs, result.Extra.Metadata.OwaspCategories())
+	if !ok {
+		return false
 	}
+	slog.Info("owasp category explicitly allowed, removing from subsequent rules", "artifact", "semgrep",
+		"owasp", owasp, "check_id", result.CheckID, "severity", result.Extra.Severity, "path", result.Path)
 	return true
 }
 
-func ruleGrypeCVEAllow(config *Config, report *artifacts.GrypeReportMin) {
-	slog.Debug("cve id risk acceptance rule", "artifact", "grype",
-		"enabled", config.Grype.CVERiskAcceptance.Enabled,
-		"risk_accepted_cves", len(config.Grype.CVERiskAcceptance.CVEs),
-	)
+// semgrepRuleIDMatch the first configured rule ID that matches the check ID
+//
+// Case insensitive, matched with globMatch so "*" matches any characters within a "/" separated segment
+// and "**" matches any number of segments, "python.lang.security.*" matches every rule in the namespace
+// and "p/owasp-top-ten/**" matches every registry rule under "p/owasp-top-ten"
+func semgrepRuleIDMatch(ruleIDs []configRuleID, checkID string) (configRuleID, bool) {
+	for _, ruleID := range ruleIDs {
+		if globMatch(strings.ToLower(ruleID.ID), strings.ToLower(checkID)) {
+			return ruleID, true
+		}
+	}
+	return configRuleID{}, false
+}
+
+// semgrepIDMatch the first configured ID in the result IDs, case insensitive
+func semgrepIDMatch(configured []string, ids []string) (string, bool) {
+	for _, id := range ids {
+		if slices.ContainsFunc(configured, func(configuredID string) bool {
+			return strings.EqualFold(strings.TrimSpace(configuredID), id)
+		}) {
+			return id, true
+		}
+	}
+	return "", false
+}
 
-	if !config.Grype.CVERiskAcceptance.Enabled {
-		return
+func sarifFindingID(finding artifacts.SarifFinding) string {
+	return findingID(finding.Finding())
+}
+
+func ruleSarifRuleIDDeny(findings []artifacts.SarifFinding, config *Config) error {
+	if !config.Sarif.RuleIDLimit.Enabled {
+		slog.Debug("rule id limits not enabled", "artifact", "sarif", "count_denied", len(config.Sarif.RuleIDLimit.RuleIDs))
+		return nil
 	}
-	matches := slices.DeleteFunc(report.Matches, func(match artifacts.GrypeMatch) bool {
-		allowed := slices.ContainsFunc(config.Grype.CVERiskAcceptance.CVEs, func(cve configCVE) bool {
-			return strings.EqualFold(cve.ID, match.Vulnerability.ID)
+	return validate.DenyFunc(findings, func(finding artifacts.SarifFinding) error {
+		i := slices.IndexFunc(config.Sarif.RuleIDLimit.RuleIDs, func(ruleID configRuleID) bool {
+			return strings.EqualFold(finding.RuleID, ruleID.ID)
 		})
-		if allowed {
-			slog.Info("CVE explicitly allowed, removing from subsequent rules",
-				"id", match.Vulnerability.ID, "severity", match.Vulnerability.Severity)
+		if i < 0 {
+			return nil
 		}
-		return allowed
+		slog.Error("rule id matched to deny list", "artifact", "sarif", "tool", finding.Tool,
+			"rule_id", finding.RuleID, "location", finding.Location, "metadata", fmt.Sprintf("%+v", config.Sarif.RuleIDLimit.RuleIDs[i]))
+		return validate.NewFailedRuleError("sarif.ruleIdLimit", sarifFindingID(finding))
 	})
+}
 
-	report.Matches = matches
+func ruleSarifRuleIDAllow(finding artifacts.SarifFinding, config *Config) bool {
+	if !config.Sarif.RuleIDRiskAcceptance.Enabled {
+		return false
+	}
+	allowed := slices.ContainsFunc(config.Sarif.RuleIDRiskAcceptance.RuleIDs, func(ruleID configRuleID) bool {
+		return strings.EqualFold(ruleID.ID, finding.RuleID)
+	})
+	if allowed {
+		slog.Info("rule id explicitly allowed, removing from subsequent rules", "tool", finding.Tool,
+			"rule_id", finding.RuleID, "level", finding.Level, "location", finding.Location)
+	}
+	return allowed
 }
 
-func ruleCyclonedxCVEAllow(config *Config, report *artifacts.CyclonedxReportMin) {
-	slog.Debug(
-		"cve id risk acceptance rule", "artifact", "cyclonedx",
-		"enabled", config.Cyclonedx.CVERiskAcceptance.Enabled,
-		"risk_accepted_cves", len(config.Cyclonedx.CVERiskAcceptance.CVEs),
+// sarifLevelFinding the normalized finding with the level as the severity for the level limit
+func sarifLevelFinding(finding *artifacts.SarifFinding) artifacts.Finding {
+	normalized := finding.Finding()
+	normalized.Severity = finding.Level
+	return normalized
+}
+
+func sarifLevelLimits(config *Config) severityLimits {
+	limit := config.Sarif.LevelLimit
+	return severityLimits{artifact: "sarif", rule: "sarif.levelLimit", limits: []severityLimit{
+		{"error", limit.Error},
+		{"warning", limit.Warning},
+		{"note", limit.Note},
+	}}
+}
+
+func sarifSeverityLimits(config *Config) severityLimits {
+	limit := config.Sarif.SeverityLimit
+	return severityLimits{artifact: "sarif", rule: "sarif.severityLimit", limits: []severityLimit{
+		{"critical", limit.Critical},
+		{"high", limit.High},
+		{"medium", limit.Medium},
+		{"low", limit.Low},
+	}}
+}
+
+func zapAlertID(alert artifacts.ZapAlert) string {
+	return findingID(alert.Finding())
+}
+
+func ruleZapPluginIDDeny(alerts []artifacts.ZapAlert, config *Config) error {
+	if !config.Zap.PluginIDLimit.Enabled {
+		slog.Debug("plugin id limits not enabled", "artifact", "zap", "count_denied", len(config.Zap.PluginIDLimit.PluginIDs))
+		return nil
+	}
+	return validate.DenyFunc(alerts, func(alert artifacts.ZapAlert) error {
+		i := slices.IndexFunc(config.Zap.PluginIDLimit.PluginIDs, func(pluginID configPluginID) bool {
+			return strings.TrimSpace(alert.PluginID) == strings.TrimSpace(pluginID.ID)
+		})
+		if i < 0 {
+			return nil
+		}
+		slog.Error("plugin id matched to deny list", "artifact", "zap", "plugin_id", alert.PluginID,
+			"alert", alert.Alert, "risk", alert.Risk(), "uri", alert.URIShort(), "metadata", fmt.Sprintf("%+v", config.Zap.PluginIDLimit.PluginIDs[i]))
+		return validate.NewFailedRuleError("zap.pluginIdLimit", zapAlertID(alert))
+	})
+}
+
+func ruleZapPluginIDAllow(alert artifacts.ZapAlert, config *Config) bool {
+	if !zapPluginIDAccepted(config, alert) {
+		return false
+	}
+	slog.Info("plugin id explicitly allowed, removing from subsequent rules", "artifact", "zap",
+		"plugin_id", alert.PluginID, "alert", alert.Alert, "risk", alert.Risk())
+	return true
+}
+
+// zapPluginIDAccepted the alert plugin ID is configured for risk acceptance
+func zapPluginIDAccepted(config *Config, alert artifacts.ZapAlert) bool {
+	if !config.Zap.PluginIDRiskAcceptance.Enabled {
+		return false
+	}
+	return slices.ContainsFunc(config.Zap.PluginIDRiskAcceptance.PluginIDs, func(pluginID configPluginID) bool {
+		return strings.TrimSpace(pluginID.ID) == strings.TrimSpace(alert.PluginID)
+	})
+}
+
+func ruleZapConfidenceRiskAccept(alert artifacts.ZapAlert, config *Config) bool {
+	if !zapConfidenceAccepted(config, alert) {
+		return false
+	}
+	slog.Info(
+		"risk accepted: alert confidence is configured for risk acceptance", "artifact", "zap",
+		"plugin_id", alert.PluginID,
+		"alert", alert.Alert,
+		"risk", alert.Risk(),
+		"confidence", alert.ConfidenceLevel(),
 	)
+	return true
+}
 
-	if !config.Cyclonedx.CVERiskAcceptance.Enabled {
-		return
+// zapConfidenceAccepted the alert confidence is configured for risk acceptance
+func zapConfidenceAccepted(config *Config, alert artifacts.ZapAlert) bool {
+	if !config.Zap.ConfidenceRiskAcceptance.Enabled {
+		return false
 	}
+	switch alert.ConfidenceLevel() {
+	case "false positive":
+		return config.Zap.ConfidenceRiskAcceptance.FalsePositive
+	case "low":
+		return config.Zap.ConfidenceRiskAcceptance.Low
+	case "medium":
+		return config.Zap.ConfidenceRiskAcceptance.Medium
+	}
+	return false
+}
+
+func zapRiskLimits(config *Config) severityLimits {
+	limit := config.Zap.RiskLimit
+	return severityLimits{artifact: "zap", rule: "zap.riskLimit", limits: []severityLimit{
+		{"high", limit.High},
+		{"medium", limit.Medium},
+		{"low", limit.Low},
+		{"informational", limit.Informational},
+	}}
+}
+
+// iacFindingID the check ID and resource, a check ID can fail for one resource and be accepted for another
+func iacFindingID(finding artifacts.IacFinding) string {
+	return findingID(finding.Finding())
+}
 
-	vulnerabilities := slices.DeleteFunc(report.Vulnerabilities, func(vulnerability artifacts.CyclonedxVulnerability) bool {
-		allowed := slices.ContainsFunc(config.Cyclonedx.CVERiskAcceptance.CVEs, func(cve configCVE) bool {
-			return strings.EqualFold(cve.ID, vulnerability.ID)
+func ruleIacCheckIDDeny(findings []artifacts.IacFinding, config *Config) error {
+	if !config.Iac.CheckIDLimit.Enabled {
+		slog.Debug("check id limits not enabled", "artifact", "iac", "count_denied", len(config.Iac.CheckIDLimit.CheckIDs))
+		return nil
+	}
+	return validate.DenyFunc(findings, func(finding artifacts.IacFinding) error {
+		i := slices.IndexFunc(config.Iac.CheckIDLimit.CheckIDs, func(checkID configCheckID) bool {
+			return iacCheckMatch(checkID, finding)
 		})
-		if allowed {
-			slog.Info("CVE explicitly allowed, removing from subsequent rules",
-				"id", vulnerability.ID, "severity", vulnerability.HighestSeverity())
+		if i < 0 {
+			return nil
 		}
-		return allowed
+		slog.Error("check id matched to deny list", "artifact", "iac", "check_id", finding.CheckID,
+			"resource", finding.Resource, "location", finding.LocationShort(), "metadata", fmt.Sprintf("%+v", config.Iac.CheckIDLimit.CheckIDs[i]))
+		return validate.NewFailedRuleError("iac.checkIdLimit", iacFindingID(finding))
 	})
+}
 
-	report.Vulnerabilities = vulnerabilities
+func ruleIacCheckIDAllow(finding artifacts.IacFinding, config *Config) bool {
+	if !iacCheckIDAccepted(config, finding) {
+		return false
+	}
+	slog.Info("check id explicitly allowed, removing from subsequent rules", "artifact", "iac",
+		"check_id", finding.CheckID, "severity", finding.Severity, "resource", finding.Resource)
+	return true
 }
 
-func ruleGrypeKEVLimit(config *Config, report *artifacts.GrypeReportMin, catalog *kev.Catalog) bool {
-	if !config.Grype.KEVLimitEnabled {
-		slog.Debug("kev limit not enabled", "artifact", "grype")
+// iacCheckIDAccepted the finding check ID and resource are configured for risk acceptance
+func iacCheckIDAccepted(config *Config, finding artifacts.IacFinding) bool {
+	if !config.Iac.CheckIDRiskAcceptance.Enabled {
+		return false
+	}
+	return slices.ContainsFunc(config.Iac.CheckIDRiskAcceptance.CheckIDs, func(checkID configCheckID) bool {
+		return iacCheckMatch(checkID, finding)
+	})
+}
+
+// iacCheckMatch case insensitive check ID match, the resource address must match the glob if set
+func iacCheckMatch(configured configCheckID, finding artifacts.IacFinding) bool {
+	if !strings.EqualFold(configured.ID, finding.CheckID) {
+		return false
+	}
+	if configured.Resource == "" {
 		return true
 	}
-	if catalog == nil {
-		slog.Error("kev limit enabled but no catalog data exists")
+	matched, err := path.Match(configured.Resource, finding.Resource)
+	if err != nil {
+		slog.Warn("invalid resource glob", "check_id", configured.ID, "resource", configured.Resource, "error", err)
 		return false
 	}
-	badCVEs := make([]string, 0)
-	// Check if vulnerability is in the KEV Catalog
-	for _, vulnerability := range report.Matches {
-		inKEVCatalog := slices.ContainsFunc(catalog.Vulnerabilities, func(kevVul kev.Vulnerability) bool {
-			return kevVul.CveID == vulnerability.Vulnerability.ID
-		})
-		if inKEVCatalog {
-			badCVEs = append(badCVEs, vulnerability.Vulnerability.ID)
-			slog.Warn("cve found in kev catalog",
-				"cve_id", vulnerability.Vulnerability.ID)
+	return matched
+}
+
+func iacSeverityLimits(config *Config) severityLimits {
+	limit := config.Iac.SeverityLimit
+	return severityLimits{artifact: "iac", rule: "iac.severityLimit", limits: []severityLimit{
+		{"critical", limit.Critical},
+		{"high", limit.High},
+		{"medium", limit.Medium},
+		{"low", limit.Low},
+	}}
+}
+
+func ruleGitleaksAllow(finding artifacts.GitleaksFinding, config *Config) bool {
+	entry, ok := gitleaksAllowEntry(config, finding)
+	if !ok {
+		return false
+	}
+	slog.Info("risk accepted: finding matched allowlist, removing from subsequent rules", "artifact", "gitleaks",
+		"rule_id", finding.RuleID, "file", finding.File, "commit", finding.CommitShort(), "line", finding.StartLine,
+		"fingerprint", finding.Fingerprint, "reason", entry.Reason, "expires_at", entry.ExpiresAt)
+	return true
+}
+
+// gitleaksAllowEntry the first unexpired allowlist entry that matches the finding
+func gitleaksAllowEntry(config *Config, finding artifacts.GitleaksFinding) (configGitleaksAllow, bool) {
+	if !config.Gitleaks.Allowlist.Enabled {
+		return configGitleaksAllow{}, false
+	}
+	for _, entry := range config.Gitleaks.Allowlist.Entries {
+		if !gitleaksAllowMatch(entry, finding) {
+			continue
+		}
+		expired, err := configExpired(entry.ExpiresAt)
+		if err != nil {
+			slog.Error("invalid allowlist expiration", "artifact", "gitleaks", "rule_id", entry.RuleID,
+				"fingerprint", entry.Fingerprint, "expires_at", entry.ExpiresAt, "error", err)
+			continue
 		}
+		if expired {
+			slog.Warn("allowlist entry expired", "artifact", "gitleaks", "rule_id", finding.RuleID,
+				"fingerprint", finding.Fingerprint, "reason", entry.Reason, "expires_at", entry.ExpiresAt)
+			continue
+		}
+		return entry, true
 	}
-	if len(badCVEs) > 0 {
-		slog.Error("cve(s) found in kev catalog",
-			"vulnerabilities", len(badCVEs), "kev_catalog_count", len(catalog.Vulnerabilities))
+	return configGitleaksAllow{}, false
+}
+
+// gitleaksAllowMatch every field that is set must match, an entry with no fields never matches
+func gitleaksAllowMatch(entry configGitleaksAllow, finding artifacts.GitleaksFinding) bool {
+	if entry.Fingerprint == "" && entry.RuleID == "" && entry.File == "" {
+		return false
+	}
+	if entry.Fingerprint != "" && entry.Fingerprint != finding.Fingerprint {
+		return false
+	}
+	if entry.RuleID != "" && !strings.EqualFold(entry.RuleID, finding.RuleID) {
+		return false
+	}
+	if entry.File != "" && !globMatch(entry.File, finding.File) {
 		return false
 	}
-	slog.Info("kev limit validated, no cves in catalog",
-		"vulnerabilities", len(report.Matches), "kev_catalog_count", len(catalog.Vulnerabilities))
 	return true
 }
 
-func ruleCyclonedxKEVLimit(config *Config, report *artifacts.CyclonedxReportMin, catalog *kev.Catalog) bool {
-	if !config.Cyclonedx.KEVLimitEnabled {
-		slog.Debug("kev limit not enabled", "artifact", "cyclonedx")
-		return true
+// gitleaksID the fingerprint, the rule ID and file:line if the report doesn't include fingerprints
+func gitleaksID(finding artifacts.GitleaksFinding) string {
+	if finding.Fingerprint == "" {
+		return fmt.Sprintf("%s %s:%d", finding.RuleID, finding.File, finding.StartLine)
+	}
+	return finding.Fingerprint
+}
+
+func ruleGitLeaksLimit(findings []artifacts.GitleaksFinding, config *Config) error {
+	if !config.Gitleaks.LimitEnabled {
+		slog.Debug("secrets limit not enabled", "artifact", "gitleaks")
+		return nil
+	}
+	if len(findings) > 0 {
+		slog.Error("committed secrets violation", "artifacts", "gitleaks", "secrets_detected", len(findings))
+		return failedRuleErrs("gitleaks.limitEnabled", findings, gitleaksID)
+	}
+	return nil
+}
+
+// trufflehogID the detector and location, a detector can report verified and unverified secrets
+func trufflehogID(finding artifacts.TrufflehogFinding) string {
+	return finding.DetectorName + " " + finding.LocationShort()
+}
+
+func ruleTrufflehogVerifiedLimit(findings []artifacts.TrufflehogFinding, config *Config) error {
+	report := artifacts.TrufflehogReportMin(findings)
+	verified := report.SelectByVerified(true)
+	configuredLimit := config.Trufflehog.VerifiedLimit
+	if !configuredLimit.Enabled {
+		slog.Debug("verified limit not enabled", "artifact", "trufflehog", "reported", len(verified))
+		return nil
+	}
+	if len(verified) > int(configuredLimit.Limit) {
+		for _, finding := range verified {
+			slog.Error("verified secret", "artifact", "trufflehog", "detector", finding.DetectorName,
+				"location", finding.LocationShort(), "commit", finding.CommitShort(), "redacted", finding.Redacted)
+		}
+		slog.Error("verified limit exceeded", "artifact", "trufflehog", "report", len(verified), "limit", configuredLimit.Limit)
+		return failedRuleErrs("trufflehog.verifiedLimit", verified, trufflehogID)
+	}
+	slog.Info("verified limit valid", "artifact", "trufflehog", "reported", len(verified), "limit", configuredLimit.Limit)
+	return nil
+}
+
+func ruleTrufflehogUnverifiedLimit(findings []artifacts.TrufflehogFinding, config *Config) error {
+	report := artifacts.TrufflehogReportMin(findings)
+	if !config.Trufflehog.UnverifiedLimit.Enabled {
+		slog.Debug("unverified limit not enabled", "artifact", "trufflehog", "reported", len(report.SelectByVerified(false)))
+		return nil
+	}
+
+	var errs error
+	for _, detector := range config.Trufflehog.UnverifiedLimit.Detectors {
+		matches := report.SelectUnverifiedByDetector(detector.Name)
+		matchCount := len(matches)
+		if matchCount > int(detector.Limit) {
+			slog.Error("unverified limit exceeded", "artifact", "trufflehog", "detector", detector.Name, "report", matchCount, "limit", detector.Limit)
+			errs = errors.Join(errs, failedRuleErrs("trufflehog.unverifiedLimit", matches, trufflehogID))
+			continue
+		}
+		slog.Info("unverified limit valid", "artifact", "trufflehog", "detector", detector.Name, "reported", matchCount, "limit", detector.Limit)
+	}
+	return errs
+}
+
+func syftPackageID(pkg artifacts.SyftPackage) string {
+	if len(pkg.Locations) == 0 {
+		return pkg.Name + "@" + pkg.Version
 	}
-	if catalog == nil {
-		slog.Error("kev limit enabled but no catalog data exists", "artifact", "cyclonedx")
-		return false
+	return pkg.Name + "@" + pkg.Version + " " + pkg.Locations[0].Path
+}
+
+func ruleSyftPackageLimit(pkgs []artifacts.SyftPackage, config *Config) error {
+	if !config.Syft.PackageLimit.Enabled {
+		slog.Debug("package limit not enabled", "artifact", "syft", "count_denied", len(config.Syft.PackageLimit.Packages))
+		return nil
 	}
-	badCVEs := make([]string, 0)
-	// Check if vulnerability is in the KEV Catalog
-	for _, vulnerability := range report.Vulnerabilities {
-		inKEVCatalog := slices.ContainsFunc(catalog.Vulnerabilities, func(kevVul kev.Vulnerability) bool {
-			return strings.EqualFold(kevVul.CveID, vulnerability.ID)
-		})
 
-		if inKEVCatalog {
-			badCVEs = append(badCVEs, vulnerability.ID)
-			slog.Warn("cve found in kev catalog",
-				"cve_id", vulnerability.ID)
+	return validate.DenyFunc(pkgs, func(pkg artifacts.SyftPackage) error {
+		i := slices.IndexFunc(config.Syft.PackageLimit.Packages, func(denied configPackage) bool {
+			return configPackageMatch(denied, pkg.Name, pkg.Version, pkg.Type)
+		})
+		if i < 0 {
+			return nil
 		}
-	}
-	if len(badCVEs) > 0 {
-		slog.Error("cve(s) found in kev catalog",
-			"vulnerabilities", len(badCVEs), "kev_catalog_count", len(catalog.Vulnerabilities))
-		return false
-	}
-	slog.Info("kev limit validated, no cves in catalog",
-		"vulnerabilities", len(report.Vulnerabilities), "kev_catalog_count", len(catalog.Vulnerabilities))
-	return true
+		slog.Error("package matched to deny list", "artifact", "syft",
+			"name", pkg.Name, "version", pkg.Version, "type", pkg.Type, "metadata", fmt.Sprintf("%+v", config.Syft.PackageLimit.Packages[i]))
+		return validate.NewFailedRuleError("syft.packageLimit", syftPackageID(pkg))
+	})
 }
 
-func ruleGrypeEPSSAllow(config *Config, report *artifacts.GrypeReportMin, data *epss.Data) {
-	if !config.Grype.EPSSRiskAcceptance.Enabled {
-		slog.Debug("epss risk acceptance not enabled", "artifact", "grype")
-		return
-	}
-	if data == nil {
-		slog.Error("epss allowance enabled but no data exists")
-		return
-	}
-	slog.Debug("run epss risk acceptance filter",
-		"artifact", "grype",
-		"vulnerabilities", len(report.Matches),
-		"epss_risk_acceptance_score", config.Cyclonedx.EPSSRiskAcceptance.Score,
+func ruleSyftRequiredMetadata(pkgs []artifacts.SyftPackage, config *Config) error {
+	required := config.Syft.RequiredMetadata
+	slog.Debug("required metadata rule", "artifact", "syft",
+		"enabled", required.Enabled, "version", required.Version, "purl", required.PURL, "license", required.License,
 	)
-	matches := slices.DeleteFunc(report.Matches, func(match artifacts.GrypeMatch) bool {
-		epssCVE, ok := data.CVEs[match.Vulnerability.ID]
-		if !ok {
-			slog.Debug("no epss score", "cve_id", match.Vulnerability.ID, "severity", match.Vulnerability.Severity)
-			return false
+	if !required.Enabled {
+		return nil
+	}
+
+	missingPkgs := slices.DeleteFunc(slices.Clone(pkgs), func(pkg artifacts.SyftPackage) bool {
+		missing := []string{}
+		if required.Version && pkg.Version == "" {
+			missing = append(missing, "version")
+		}
+		if required.PURL && pkg.PURL == "" {
+			missing = append(missing, "purl")
 		}
-		riskAccepted := config.Grype.EPSSRiskAcceptance.Score > epssCVE.EPSSValue()
-		if riskAccepted {
-			slog.Info(
-				"risk accepted reason: epss score",
-				"cve_id", match.Vulnerability.ID,
-				"severity", match.Vulnerability.Severity,
-				"epss_score", epssCVE.EPSS,
-			)
+		if required.License && len(pkg.Licenses) == 0 {
+			missing = append(missing, "license")
+		}
+		if len(missing) == 0 {
 			return true
 		}
+		slog.Warn("package missing required metadata", "artifact", "syft",
+			"name", pkg.Name, "version", pkg.Version, "type", pkg.Type, "missing", strings.Join(missing, ", "))
 		return false
 	})
 
-	report.Matches = matches
+	if len(missingPkgs) > 0 {
+		slog.Error("package(s) missing required metadata", "artifact", "syft",
+			"packages_missing_metadata", len(missingPkgs), "packages", len(pkgs))
+		return failedRuleErrs("syft.requiredMetadata", missingPkgs, syftPackageID)
+	}
+	return nil
+}
+
+func spdxPackageID(pkg artifacts.SpdxPackage) string {
+	return pkg.Name + "@" + pkg.VersionInfo + " " + pkg.SPDXID
 }
 
-func ruleCyclonedxEPSSAllow(config *Config, report *artifacts.CyclonedxReportMin, data *epss.Data) {
-	if !config.Cyclonedx.EPSSRiskAcceptance.Enabled {
-		slog.Debug("epss risk acceptance not enabled", "artifact", "cyclonedx")
-		return
-	}
-	if data == nil {
-		slog.Error("epss allowance enabled but no data exists", "artifact", "cyclonedx")
-		return
+func ruleSpdxPackageLimit(pkgs []artifacts.SpdxPackage, config *Config) error {
+	if !config.Spdx.PackageLimit.Enabled {
+		slog.Debug("package limit not enabled", "artifact", "spdx", "count_denied", len(config.Spdx.PackageLimit.Packages))
+		return nil
 	}
-	slog.Debug("run epss risk acceptance filter",
-		"artifact", "cyclonedx",
-		"vulnerabilities", len(report.Vulnerabilities),
-		"epss_risk_acceptance_score", config.Cyclonedx.EPSSRiskAcceptance.Score,
-	)
-	vulnerabilities := slices.DeleteFunc(report.Vulnerabilities, func(vulnerability artifacts.CyclonedxVulnerability) bool {
-		epssCVE, ok := data.CVEs[vulnerability.ID]
-		if !ok {
-			slog.Debug("no epss score", "cve_id", vulnerability.ID, "severity", vulnerability.HighestSeverity())
-			return false
-		}
-		riskAccepted := config.Cyclonedx.EPSSRiskAcceptance.Score > epssCVE.EPSSValue()
-		if riskAccepted {
-			slog.Info(
-				"risk accepted reason: epss score",
-				"cve_id", vulnerability.ID,
-				"severity", vulnerability.HighestSeverity(),
-				"epss_score", epssCVE.EPSS,
-			)
-			return true
+
+	return validate.DenyFunc(pkgs, func(pkg artifacts.SpdxPackage) error {
+		i := slices.IndexFunc(config.Spdx.PackageLimit.Packages, func(denied configPackage) bool {
+			return configPackageMatch(denied, pkg.Name, pkg.VersionInfo, pkg.PURLType())
+		})
+		if i < 0 {
+			return nil
 		}
-		return false
+		slog.Error("package matched to deny list", "artifact", "spdx",
+			"name", pkg.Name, "version", pkg.VersionInfo, "purl", pkg.PURL(), "metadata", fmt.Sprintf("%+v", config.Spdx.PackageLimit.Packages[i]))
+		return validate.NewFailedRuleError("spdx.packageLimit", spdxPackageID(pkg))
 	})
-
-	report.Vulnerabilities = vulnerabilities
 }
 
-func ruleGrypeEPSSLimit(config *Config, report *artifacts.GrypeReportMin, data *epss.Data) bool {
-	if !config.Grype.EPSSLimit.Enabled {
-		slog.Debug("epss limit not enabled", "artifact", "grype")
-		return true
-	}
-	if data == nil {
-		slog.Error("epss allowance enabled but no data exists")
-		return false
+func ruleSpdxLicenseLimit(pkgs []artifacts.SpdxPackage, config *Config) error {
+	if !config.Spdx.LicenseLimit.Enabled {
+		slog.Debug("license limit not enabled", "artifact", "spdx", "count_denied", len(config.Spdx.LicenseLimit.Licenses))
+		return nil
 	}
 
-	badCVEs := make([]epss.CVE, 0)
+	var errs error
+	for _, pkg := range pkgs {
+		for _, licenseID := range pkg.LicenseIDs() {
+			denied := slices.ContainsFunc(config.Spdx.LicenseLimit.Licenses, func(license string) bool {
+				return strings.EqualFold(license, licenseID)
+			})
+			if !denied {
+				continue
+			}
+			slog.Error("license matched to deny list", "artifact", "spdx",
+				"name", pkg.Name, "version", pkg.VersionInfo, "license", licenseID,
+				"declared", pkg.LicenseDeclared, "concluded", pkg.LicenseConcluded)
+			errs = errors.Join(errs, validate.NewFailedRuleError("spdx.licenseLimit", spdxPackageID(pkg)))
+		}
+	}
+	return errs
+}
 
-	slog.Debug("run epss limit rule",
-		"artifact", "grype",
-		"vulnerabilities", len(report.Matches),
-		"epss_risk_acceptance_score", config.Grype.EPSSRiskAcceptance.Score,
+func ruleSpdxRequiredMetadata(pkgs []artifacts.SpdxPackage, config *Config) error {
+	required := config.Spdx.RequiredMetadata
+	slog.Debug("required metadata rule", "artifact", "spdx",
+		"enabled", required.Enabled, "version", required.Version, "purl", required.PURL, "license", required.License,
 	)
-	for _, match := range report.Matches {
-		epssCVE, ok := data.CVEs[match.Vulnerability.ID]
-		if !ok {
-			continue
+	if !required.Enabled {
+		return nil
+	}
+
+	missingPkgs := slices.DeleteFunc(slices.Clone(pkgs), func(pkg artifacts.SpdxPackage) bool {
+		missing := []string{}
+		if required.Version && pkg.VersionInfo == "" {
+			missing = append(missing, "version")
 		}
-		// add to badCVEs if the score is higher than the limit
-		if epssCVE.EPSSValue() > config.Grype.EPSSLimit.Score {
-			badCVEs = append(badCVEs, epssCVE)
-			slog.Warn(
-				"epss score limit violation",
-				"cve_id", match.Vulnerability.ID,
-				"severity", match.Vulnerability.Severity,
-				"epss_score", epssCVE.EPSS,
-			)
+		if required.PURL && pkg.PURL() == "" {
+			missing = append(missing, "purl")
 		}
-	}
-	if len(badCVEs) > 0 {
-		slog.Error("cve(s) with epss scores over limit",
-			"over_limit_cves", len(badCVEs),
-			"epss_limit_score", config.Grype.EPSSLimit.Score,
-		)
+		if required.License && len(pkg.Licenses()) == 0 {
+			missing = append(missing, "license")
+		}
+		if len(missing) == 0 {
+			return true
+		}
+		slog.Warn("package missing required metadata", "artifact", "spdx",
+			"name", pkg.Name, "version", pkg.VersionInfo, "spdx_id", pkg.SPDXID, "missing", strings.Join(missing, ", "))
 		return false
+	})
+
+	if len(missingPkgs) > 0 {
+		slog.Error("package(s) missing required metadata", "artifact", "spdx",
+			"packages_missing_metadata", len(missingPkgs), "packages", len(pkgs))
+		return failedRuleErrs("spdx.requiredMetadata", missingPkgs, spdxPackageID)
 	}
-	return true
+	return nil
 }
 
-func ruleCyclonedxEPSSLimit(config *Config, report *artifacts.CyclonedxReportMin, data *epss.Data) bool {
-	if !config.Cyclonedx.EPSSLimit.Enabled {
-		slog.Debug("epss limit not enabled", "artifact", "cyclonedx")
-		return true
-	}
-	if data == nil {
-		slog.Error("epss allowance enabled but no data exists")
+// configPackageMatch name is case insensitive, empty version or type match any value
+func configPackageMatch(configured configPackage, name string, version string, pkgType string) bool {
+	if !strings.EqualFold(configured.Name, name) {
 		return false
 	}
-
-	badCVEs := make([]epss.CVE, 0)
-
-	slog.Debug("run epss limit rule",
-		"artifact", "cyclonedx",
-		"vulnerabilities", len(report.Vulnerabilities),
-		"epss_risk_acceptance_score", config.Cyclonedx.EPSSRiskAcceptance.Score,
-	)
-
-	for _, vulnerability := range report.Vulnerabilities {
-		epssCVE, ok := data.CVEs[vulnerability.ID]
-		if !ok {
-			continue
-		}
-		// add to badCVEs if the score is higher than the limit
-		if epssCVE.EPSSValue() > config.Cyclonedx.EPSSLimit.Score {
-			badCVEs = append(badCVEs, epssCVE)
-			slog.Warn(
-				"epss score limit violation",
-				"cve_id", vulnerability.ID,
-				"severity", vulnerability.HighestSeverity(),
-				"epss_score", epssCVE.EPSS,
-			)
-		}
+	if configured.Version != "" && configured.Version != version {
+		return false
 	}
-	if len(badCVEs) > 0 {
-		slog.Error("cve(s) with epss scores over limit",
-			"over_limit_cves", len(badCVEs),
-			"epss_limit_score", config.Cyclonedx.EPSSLimit.Score,
-		)
+	if configured.Type != "" && !strings.EqualFold(configured.Type, pkgType) {
 		return false
 	}
 	return true
 }
 
-func ruleSemgrepSeverityLimit(config *Config, report *artifacts.SemgrepReportMin) bool {
-	slog.Debug(
-		"severity limit rule", "artifact", "semgrep",
-		"error_enabled", config.Semgrep.SeverityLimit.Error.Enabled,
-		"info_enabled", config.Semgrep.SeverityLimit.Info.Enabled,
-		"warning_enabled", config.Semgrep.SeverityLimit.Warning.Enabled,
-	)
-
-	validationPass := true
-
-	limits := map[string]configLimit{
-		"error":   config.Semgrep.SeverityLimit.Error,
-		"warning": config.Semgrep.SeverityLimit.Warning,
-		"info":    config.Semgrep.SeverityLimit.Info,
-	}
-
-	for _, severity := range []string{"error", "warning", "info"} {
+// globMatch path.Match for each segment, a "**" segment matches any number of segments
+func globMatch(pattern string, name string) bool {
+	return globMatchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
 
-		configuredLimit := limits[severity]
-		matches := report.SelectBySeverity(severity)
-		matchCount := len(matches)
-		if !configuredLimit.Enabled {
-			slog.Debug("severity limit not enabled", "artifact", "semgrep", "severity", severity, "reported", matchCount)
-			continue
+func globMatchSegments(pattern []string, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if globMatchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
 		}
-		if matchCount > int(configuredLimit.Limit) {
-			slog.Error("severity limit exceeded", "artifact", "semgrep", "severity", severity, "report", matchCount, "limit", configuredLimit.Limit)
-			validationPass = false
-			continue
+		if len(name) == 0 {
+			return false
 		}
-		slog.Info("severity limit valid", "artifact", "semgrep", "severity", severity, "reported", matchCount, "limit", configuredLimit.Limit)
+		if matched, err := path.Match(pattern[0], name[0]); err != nil || !matched {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
 	}
+	return len(name) == 0
+}
 
-	return validationPass
+// configExpired an empty expiration never expires, a date expires at the end of the day in UTC
+func configExpired(expiresAt string) (bool, error) {
+	expiration, err := configExpiration(expiresAt)
+	if err != nil || expiration.IsZero() {
+		return false, err
+	}
+	return !time.Now().Before(expiration), nil
 }
 
-func ruleSemgrepImpactRiskAccept(config *Config, report *artifacts.SemgrepReportMin) {
-	slog.Debug(
-		"impact risk accept rule", "artifact", "semgrep",
-		"enabled", config.Semgrep.ImpactRiskAcceptance.Enabled,
-		"high", config.Semgrep.ImpactRiskAcceptance.High,
-		"medium", config.Semgrep.ImpactRiskAcceptance.Medium,
-		"low", config.Semgrep.ImpactRiskAcceptance.Low,
-	)
+// configExpiration the time an acceptance stops applying, zero if it never expires
+//
+// A date expires at the end of that day in UTC
+func configExpiration(expiresAt string) (time.Time, error) {
+	expiresAt = strings.TrimSpace(expiresAt)
+	if expiresAt == "" {
+		return time.Time{}, nil
+	}
+	if date, err := time.Parse(time.DateOnly, expiresAt); err == nil {
+		return date.AddDate(0, 0, 1), nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expiration must be a date (YYYY-MM-DD) or an RFC 3339 timestamp: %w", err)
+	}
+	return timestamp, nil
+}
 
-	if !config.Semgrep.ImpactRiskAcceptance.Enabled {
-		slog.Debug("impact risk acceptance not enabled", "artifact", "semgrep")
-		return
-	}
-
-	results := slices.DeleteFunc(report.Results, func(result artifacts.SemgrepResults) bool {
-		riskAccepted := false
-		switch {
-		case config.Semgrep.ImpactRiskAcceptance.High && strings.EqualFold(result.Extra.Metadata.Impact, "high"):
-			riskAccepted = true
-		case config.Semgrep.ImpactRiskAcceptance.Medium && strings.EqualFold(result.Extra.Metadata.Impact, "medium"):
-			riskAccepted = true
-		case config.Semgrep.ImpactRiskAcceptance.Low && strings.EqualFold(result.Extra.Metadata.Impact, "low"):
-			riskAccepted = true
-		}
-
-		if riskAccepted {
-			slog.Info(
-				"risk accepted: epss score is below risk acceptance threshold",
-				"check_id", result.CheckID,
-				"severity", result.Extra.Severity,
-				"impact", result.Extra.Metadata.Impact,
-			)
-			return true
-		}
-		return false
+// fixStateAccepted case insensitive match to an accepted state
+func fixStateAccepted(acceptance configFixStateRiskAcceptance, state string) bool {
+	return slices.ContainsFunc(acceptance.States, func(accepted string) bool {
+		return strings.EqualFold(strings.TrimSpace(accepted), state)
 	})
+}
 
-	report.Results = results
+// cveAcceptanceExpired an invalid expiration counts as expired so a typo can't accept a CVE forever
+func cveAcceptanceExpired(cve configCVE) bool {
+	expired, err := configExpired(cve.ExpiresAt)
+	return expired || err != nil
 }
 
-func ruleGitLeaksLimit(config *Config, report *artifacts.GitLeaksReportMin) bool {
-	if !config.Gitleaks.LimitEnabled {
-		slog.Debug("secrets limit not enabled", "artifact", "gitleaks")
-		return true
-	}
-	detectedSecrets := report.Count()
-	if detectedSecrets > 0 {
-		slog.Error("committed secrets violation", "artifacts", "gitleaks", "secrets_detected", detectedSecrets)
-		return false
+// activeCVEs risk acceptances that have not expired
+func activeCVEs(cves []configCVE) []configCVE {
+	return slices.DeleteFunc(slices.Clone(cves), cveAcceptanceExpired)
+}
+
+// expiredCVEs risk acceptances that have expired or have an invalid expiration
+func expiredCVEs(cves []configCVE) []configCVE {
+	return slices.DeleteFunc(slices.Clone(cves), func(cve configCVE) bool {
+		return !cveAcceptanceExpired(cve)
+	})
+}
+
+// ruleCVERiskAcceptanceExpired fail each reported vulnerability an expired acceptance matches,
+// reported returns the IDs of the matching vulnerabilities
+//
+// Expired acceptances that match nothing are only a warning so they can be cleaned up
+func ruleCVERiskAcceptanceExpired(artifact string, acceptance configCVERiskAcceptance, reported func(configCVE) []string) error {
+	if !acceptance.Enabled {
+		return nil
 	}
-	return true
+
+	return validate.DenyFunc(expiredCVEs(acceptance.CVEs), func(cve configCVE) error {
+		if _, err := configExpired(cve.ExpiresAt); err != nil {
+			slog.Error("invalid cve risk acceptance expiration, treated as expired", "artifact", artifact,
+				"id", cve.ID, "expires_at", cve.ExpiresAt, "error", err)
+		}
+		ids := reported(cve)
+		if len(ids) == 0 {
+			slog.Warn("cve risk acceptance expired, no matching vulnerability reported", "artifact", artifact,
+				"id", cve.ID, "expires_at", cve.ExpiresAt, "owner", cve.Owner, "ticket", cve.Ticket)
+			return nil
+		}
+		slog.Error("cve risk acceptance expired", "artifact", artifact,
+			"id", cve.ID, "expires_at", cve.ExpiresAt, "owner", cve.Owner, "ticket", cve.Ticket, "reason", cve.Reason)
+		return failedRuleErrs(artifact+".cveRiskAcceptance.expiresAt", ids, func(id string) string { return id })
+	})
 }
 
 func loadCatalogFromFileOrAPI(catalog *kev.Catalog, options *fetchOptions) error {
@@ -540,7 +1477,8 @@ func loadDataFromFileOrAPI(epssData *epss.Data, options *fetchOptions) error {
 }
 
 func LoadCatalogAndData(config *Config, catalog *kev.Catalog, epssData *epss.Data, options *fetchOptions) error {
-	if config.Grype.KEVLimitEnabled || config.Cyclonedx.KEVLimitEnabled {
+	kevNeeded := config.Grype.KEVLimitEnabled || config.Cyclonedx.KEVLimitEnabled || config.Trivy.KEVLimitEnabled || config.Osv.KEVLimitEnabled
+	if kevNeeded {
 		if err := loadCatalogFromFileOrAPI(catalog, options); err != nil {
 			return err
 		}
@@ -548,8 +1486,10 @@ func LoadCatalogAndData(config *Config, catalog *kev.Catalog, epssData *epss.Dat
 
 	grypeEPSSNeeded := config.Grype.EPSSLimit.Enabled || config.Grype.EPSSRiskAcceptance.Enabled
 	cyclonedxEPSSNeeded := config.Cyclonedx.EPSSLimit.Enabled || config.Cyclonedx.EPSSRiskAcceptance.Enabled
+	trivyEPSSNeeded := config.Trivy.EPSSLimit.Enabled || config.Trivy.EPSSRiskAcceptance.Enabled
+	osvEPSSNeeded := config.Osv.EPSSLimit.Enabled || config.Osv.EPSSRiskAcceptance.Enabled
 
-	if grypeEPSSNeeded || cyclonedxEPSSNeeded {
+	if grypeEPSSNeeded || cyclonedxEPSSNeeded || trivyEPSSNeeded || osvEPSSNeeded {
 		if err := loadDataFromFileOrAPI(epssData, options); err != nil {
 			return err
 		}
@@ -568,10 +1508,10 @@ func validateGrypeReportWithFetch(r io.Reader, config *Config, options *fetchOpt
 		return errors.New("Cannot run Grype validation: Cannot load external validation data. See log for details.")
 	}
 
-	return validateGrypeFrom(r, config, catalog, epssData)
+	return validateGrypeFrom(r, config, catalog, epssData, options.validators)
 }
 
-func validateGrypeFrom(r io.Reader, config *Config, catalog *kev.Catalog, epssData *epss.Data) error {
+func validateGrypeFrom(r io.Reader, config *Config, catalog *kev.Catalog, epssData *epss.Data, validators Validators) error {
 	slog.Debug("validate grype report")
 	report := &artifacts.GrypeReportMin{}
 	if err := json.NewDecoder(r).Decode(report); err != nil {
@@ -579,7 +1519,8 @@ func validateGrypeFrom(r io.Reader, config *Config, catalog *kev.Catalog, epssDa
 		return errors.New("Cannot run Grype validation: Report decoding failed. See log for details.")
 	}
 
-	return validateGrypeRules(config, report, catalog, epssData)
+	_, err := validateGrypeRules(validators.Grype, config, report, catalog, epssData)
+	return err
 }
 
 func validateCyclonedxReportWithFetch(r io.Reader, config *Config, options *fetchOptions) error {
@@ -592,20 +1533,69 @@ func validateCyclonedxReportWithFetch(r io.Reader, config *Config, options *fetc
 		slog.Error("validate cyclonedx report: load epss data from file or api", "error", err)
 		return errors.New("Cannot run Cyclonedx validation: Cannot load external validation data. See log for details.")
 	}
-	return validateCyclonedxFrom(r, config, catalog, epssData)
+	return validateCyclonedxFrom(r, config, catalog, epssData, options.validators)
 }
 
-func validateCyclonedxFrom(r io.Reader, config *Config, catalog *kev.Catalog, epssData *epss.Data) error {
+func validateCyclonedxFrom(r io.Reader, config *Config, catalog *kev.Catalog, epssData *epss.Data, validators Validators) error {
 	report := &artifacts.CyclonedxReportMin{}
-	if err := json.NewDecoder(r).Decode(report); err != nil {
+	if err := artifacts.DecodeCyclonedx(r, report); err != nil {
 		slog.Error("decode cyclonedx report for validation", "error", err)
 		return errors.New("Cannot run Cyclonedx validation: Report decoding failed. See log for details.")
 	}
 
-	return validateCyclonedxRules(config, report, catalog, epssData)
+	_, err := validateCyclonedxRules(validators.Cyclonedx, config, report, catalog, epssData)
+	return err
+}
+
+func validateTrivyReportWithFetch(r io.Reader, config *Config, options *fetchOptions) error {
+	slog.Debug("validate trivy report")
+
+	catalog := kev.NewCatalog()
+	epssData := new(epss.Data)
+
+	if err := LoadCatalogAndData(config, catalog, epssData, options); err != nil {
+		slog.Error("validate trivy report: load epss data from file or api", "error", err)
+		return errors.New("Cannot run Trivy validation: Cannot load external validation data. See log for details.")
+	}
+	return validateTrivyFrom(r, config, catalog, epssData, options.validators)
+}
+
+func validateTrivyFrom(r io.Reader, config *Config, catalog *kev.Catalog, epssData *epss.Data, validators Validators) error {
+	report := &artifacts.TrivyReportMin{}
+	if err := json.NewDecoder(r).Decode(report); err != nil {
+		slog.Error("decode trivy report for validation", "error", err)
+		return errors.New("Cannot run Trivy validation: Report decoding failed. See log for details.")
+	}
+
+	_, err := validateTrivyRules(validators.Trivy, config, report, catalog, epssData)
+	return err
+}
+
+func validateOsvReportWithFetch(r io.Reader, config *Config, options *fetchOptions) error {
+	slog.Debug("validate osv report")
+
+	catalog := kev.NewCatalog()
+	epssData := new(epss.Data)
+
+	if err := LoadCatalogAndData(config, catalog, epssData, options); err != nil {
+		slog.Error("validate osv report: load epss data from file or api", "error", err)
+		return errors.New("Cannot run OSV validation: Cannot load external validation data. See log for details.")
+	}
+	return validateOsvFrom(r, config, catalog, epssData, options.validators)
+}
+
+func validateOsvFrom(r io.Reader, config *Config, catalog *kev.Catalog, epssData *epss.Data, validators Validators) error {
+	report := &artifacts.OsvReportMin{}
+	if err := json.NewDecoder(r).Decode(report); err != nil {
+		slog.Error("decode osv report for validation", "error", err)
+		return errors.New("Cannot run OSV validation: Report decoding failed. See log for details.")
+	}
+
+	_, err := validateOsvRules(validators.Osv, config, report, catalog, epssData)
+	return err
 }
 
-func validateSemgrepReport(r io.Reader, config *Config) error {
+func validateSemgrepReport(r io.Reader, config *Config, validators Validators) error {
 	slog.Debug("validate semgrep report")
 	report := &artifacts.SemgrepReportMin{}
 	if err := json.NewDecoder(r).Decode(report); err != nil {
@@ -613,52 +1603,141 @@ func validateSemgrepReport(r io.Reader, config *Config) error {
 		return errors.New("Cannot run Semgrep report validation: Report decoding failed. See log for details.")
 	}
 
-	return validateSemgrepRules(config, report)
+	_, err := validateSemgrepRules(validators.Semgrep, config, report)
+	return err
 }
 
-func validateGitleaksReport(r io.Reader, config *Config) error {
+func validateGitleaksReport(r io.Reader, config *Config, validators Validators) error {
 	slog.Debug("validate gitleaks report")
 	report := &artifacts.GitLeaksReportMin{}
 	if err := json.NewDecoder(r).Decode(report); err != nil {
 		slog.Error("decode gitleaks report for validation", "error", err)
 		return errors.New("Cannot run Semgrep report validation: Report decoding failed. See log for details.")
 	}
-	return validateGitleaksRules(config, report)
+	_, err := validateGitleaksRules(validators.Gitleaks, config, report)
+	return err
 }
 
-func validateBundle(r io.Reader, config *Config, options *fetchOptions) error {
-	slog.Debug("validate gatecheck bundle")
-	bundle := archive.NewBundle()
-	if err := archive.UntarGzipBundle(r, bundle); err != nil {
-		slog.Error("decode gatecheck bundle")
-		return errors.New("Cannot run Gatecheck Bundle validation: Bundle decoding failed. See log for details.")
+func validateTrufflehogReport(r io.Reader, config *Config, validators Validators) error {
+	slog.Debug("validate trufflehog report")
+	report := &artifacts.TrufflehogReportMin{}
+	if err := artifacts.DecodeTrufflehog(r, report); err != nil {
+		slog.Error("decode trufflehog report for validation", "error", err)
+		return errors.New("Cannot run TruffleHog report validation: Report decoding failed. See log for details.")
+	}
+	_, err := validateTrufflehogRules(validators.Trufflehog, config, report)
+	return err
+}
+
+func validateSyftReport(r io.Reader, config *Config, validators Validators) error {
+	slog.Debug("validate syft report")
+	report := &artifacts.SyftReportMin{}
+	if err := json.NewDecoder(r).Decode(report); err != nil {
+		slog.Error("decode syft report for validation", "error", err)
+		return errors.New("Cannot run Syft report validation: Report decoding failed. See log for details.")
+	}
+	_, err := validateSyftRules(validators.Syft, config, report)
+	return err
+}
+
+func validateGovulncheckReport(r io.Reader, config *Config, validators Validators) error {
+	slog.Debug("validate govulncheck report")
+	report := &artifacts.GovulncheckReportMin{}
+	if err := artifacts.DecodeGovulncheck(r, report); err != nil {
+		slog.Error("decode govulncheck report for validation", "error", err)
+		return errors.New("Cannot run govulncheck report validation: Report decoding failed. See log for details.")
+	}
+	_, err := validateGovulncheckRules(validators.Govulncheck, config, report)
+	return err
+}
+
+func validateSpdxReport(r io.Reader, config *Config, validators Validators) error {
+	slog.Debug("validate spdx report")
+	report := &artifacts.SpdxReportMin{}
+	if err := json.NewDecoder(r).Decode(report); err != nil {
+		slog.Error("decode spdx report for validation", "error", err)
+		return errors.New("Cannot run SPDX report validation: Report decoding failed. See log for details.")
+	}
+	if report.SPDXVersion != "SPDX-2.3" {
+		slog.Warn("spdx version does not match supported version", "want", "SPDX-2.3", "got", report.SPDXVersion)
+	}
+	_, err := validateSpdxRules(validators.Spdx, config, report)
+	return err
+}
+
+func validateSarifReport(r io.Reader, config *Config, validators Validators) error {
+	slog.Debug("validate sarif report")
+	report := &artifacts.SarifReportMin{}
+	if err := json.NewDecoder(r).Decode(report); err != nil {
+		slog.Error("decode sarif report for validation", "error", err)
+		return errors.New("Cannot run SARIF report validation: Report decoding failed. See log for details.")
+	}
+	if report.Version != artifacts.SarifVersion {
+		slog.Warn("sarif version does not match supported version", "want", artifacts.SarifVersion, "got", report.Version)
+	}
+	_, err := validateSarifRules(validators.Sarif, config, report)
+	return err
+}
+
+func validateZapReport(r io.Reader, config *Config, validators Validators) error {
+	slog.Debug("validate zap report")
+	report := &artifacts.ZapReportMin{}
+	if err := json.NewDecoder(r).Decode(report); err != nil {
+		slog.Error("decode zap report for validation", "error", err)
+		return errors.New("Cannot run ZAP report validation: Report decoding failed. See log for details.")
+	}
+	_, err := validateZapRules(validators.Zap, config, report)
+	return err
+}
+
+func validateCheckovReport(r io.Reader, config *Config, validators Validators) error {
+	slog.Debug("validate checkov report")
+	report := &artifacts.IacReportMin{}
+	if err := artifacts.DecodeCheckov(r, report); err != nil {
+		slog.Error("decode checkov report for validation", "error", err)
+		return errors.New("Cannot run Checkov report validation: Report decoding failed. See log for details.")
+	}
+	_, err := validateIacRules(validators.Iac, config, report)
+	return err
+}
+
+func validateTfsecReport(r io.Reader, config *Config, validators Validators) error {
+	slog.Debug("validate tfsec report")
+	report := &artifacts.IacReportMin{}
+	if err := artifacts.DecodeTfsec(r, report); err != nil {
+		slog.Error("decode tfsec report for validation", "error", err)
+		return errors.New("Cannot run tfsec report validation: Report decoding failed. See log for details.")
 	}
+	_, err := validateIacRules(validators.Iac, config, report)
+	return err
+}
 
+func validateBundle(r io.Reader, config *Config, options *fetchOptions) error {
 	catalog := kev.NewCatalog()
 	epssData := new(epss.Data)
 
 	if err := LoadCatalogAndData(config, catalog, epssData, options); err != nil {
-		slog.Error("validate cyclonedx report: load epss data from file or api", "error", err)
-		return errors.New("Cannot run Cyclonedx validation: Cannot load external validation data. See log for details.")
+		slog.Error("validate gatecheck bundle: load epss data from file or api", "error", err)
+		return errors.New("Cannot run Gatecheck Bundle validation: Cannot load external validation data. See log for details.")
+	}
+
+	return validateBundleFrom(r, config, catalog, epssData, options.validators)
+}
+
+func validateBundleFrom(r io.Reader, config *Config, catalog *kev.Catalog, epssData *epss.Data, validators Validators) error {
+	slog.Debug("validate gatecheck bundle")
+	bundle := archive.NewBundle()
+	if err := archive.UntarGzipBundle(r, bundle); err != nil {
+		slog.Error("decode gatecheck bundle")
+		return errors.New("Cannot run Gatecheck Bundle validation: Bundle decoding failed. See log for details.")
 	}
 
 	var errs error
 	for fileLabel, descriptor := range bundle.Manifest().Files {
-		slog.Info("gatecheck bundle validation", "file_label", fileLabel, "digest", descriptor.Digest)
-		switch {
-		case strings.Contains(fileLabel, "grype"):
-			err := validateGrypeFrom(bytes.NewBuffer(bundle.FileBytes(fileLabel)), config, catalog, epssData)
-			errs = errors.Join(errs, err)
-		case strings.Contains(fileLabel, "cyclonedx"):
-			err := validateCyclonedxFrom(bytes.NewBuffer(bundle.FileBytes(fileLabel)), config, catalog, epssData)
-			errs = errors.Join(errs, err)
-		case strings.Contains(fileLabel, "semgrep"):
-			err := validateSemgrepReport(bytes.NewBuffer(bundle.FileBytes(fileLabel)), config)
-			errs = errors.Join(errs, err)
-		case strings.Contains(fileLabel, "gitleaks"):
-			err := validateGitleaksReport(bytes.NewBuffer(bundle.FileBytes(fileLabel)), config)
-			errs = errors.Join(errs, err)
-		}
+		content := bundle.FileBytes(fileLabel)
+		reportType := DetectReportType(content)
+		slog.Info("gatecheck bundle validation", "file_label", fileLabel, "filetype", reportType, "digest", descriptor.Digest)
+		errs = errors.Join(errs, validateContentFrom(content, reportType, config, catalog, epssData, validators))
 	}
 	if errs != nil {
 		return errors.Join(newValidationErr("Gatecheck Bundle"), errs)
@@ -666,84 +1745,297 @@ func validateBundle(r io.Reader, config *Config, options *fetchOptions) error {
 	return nil
 }
 
+// validateContentFrom validate a single report with external data that is already loaded
+//
+// Unsupported report types are skipped
+func validateContentFrom(content []byte, reportType string, config *Config, catalog *kev.Catalog, epssData *epss.Data, validators Validators) error {
+	src := bytes.NewReader(content)
+	switch reportType {
+	case ReportTypeGrype:
+		return validateGrypeFrom(src, config, catalog, epssData, validators)
+	case ReportTypeCyclonedx:
+		return validateCyclonedxFrom(src, config, catalog, epssData, validators)
+	case ReportTypeSemgrep:
+		return validateSemgrepReport(src, config, validators)
+	case ReportTypeGitleaks:
+		return validateGitleaksReport(src, config, validators)
+	case ReportTypeTrufflehog:
+		return validateTrufflehogReport(src, config, validators)
+	case ReportTypeSyft:
+		return validateSyftReport(src, config, validators)
+	case ReportTypeTrivy:
+		return validateTrivyFrom(src, config, catalog, epssData, validators)
+	case ReportTypeOsv:
+		return validateOsvFrom(src, config, catalog, epssData, validators)
+	case ReportTypeSarif:
+		return validateSarifReport(src, config, validators)
+	case ReportTypeSpdx:
+		return validateSpdxReport(src, config, validators)
+	case ReportTypeGovulncheck:
+		return validateGovulncheckReport(src, config, validators)
+	case ReportTypeZap:
+		return validateZapReport(src, config, validators)
+	case ReportTypeCheckov:
+		return validateCheckovReport(src, config, validators)
+	case ReportTypeTfsec:
+		return validateTfsecReport(src, config, validators)
+	}
+	slog.Debug("skip unsupported report", "filetype", reportType)
+	return nil
+}
+
 // Validate Rules
+//
+// Each report type has a validator pipeline, the validators run in order and allowed objects are removed
+// for the validators after them. Validation stops at the first validator with a failed rule.
+// Library users can add rules from Go code to the default pipelines and pass them to Validate,
+// added rules run in the last validator after every built in risk acceptance
+//
+//	validators := gatecheck.DefaultValidators()
+//	validators.Grype = validators.Grype.WithValidationRules(myRule)
+//	err := gatecheck.Validate(config, src, "grype-report.json", gatecheck.WithValidators(validators))
+
+// Built in allow rules are named by their config key in the report section, for example "cveRiskAcceptance",
+// SARIF output names the acceptance of each suppressed result with the section, "grype.cveRiskAcceptance"
+
+// Validators the validator pipeline for each report type, fields are named after the config sections
+type Validators struct {
+	Grype       validate.Pipeline[artifacts.Finding, CVERuleConfig]
+	Cyclonedx   validate.Pipeline[artifacts.Finding, CVERuleConfig]
+	Trivy       validate.Pipeline[artifacts.Finding, CVERuleConfig]
+	Osv         validate.Pipeline[artifacts.Finding, CVERuleConfig]
+	Govulncheck validate.Pipeline[artifacts.GovulncheckVulnerability, *Config]
+	Semgrep     validate.Pipeline[artifacts.SemgrepResults, SemgrepRuleConfig]
+	Gitleaks    validate.Pipeline[artifacts.GitleaksFinding, *Config]
+	Trufflehog  validate.Pipeline[artifacts.TrufflehogFinding, *Config]
+	Syft        validate.Pipeline[artifacts.SyftPackage, *Config]
+	Spdx        validate.Pipeline[artifacts.SpdxPackage, *Config]
+	Sarif       validate.Pipeline[artifacts.SarifFinding, *Config]
+	Zap         validate.Pipeline[artifacts.ZapAlert, *Config]
+	Iac         validate.Pipeline[artifacts.IacFinding, *Config]
+}
 
-func validateGrypeRules(config *Config, report *artifacts.GrypeReportMin, catalog *kev.Catalog, data *epss.Data) error {
-	// 1. Deny List - Fail Matching
-	if !ruleGrypeCVEDeny(config, report) {
-		return newValidationErr("Grype: CVE explicitly denied")
+// DefaultValidators the built in pipelines, adding rules to the returned pipelines never changes the defaults
+func DefaultValidators() Validators {
+	return Validators{
+		Grype:       newCVEValidator(),
+		Cyclonedx:   newCVEValidator(),
+		Trivy:       newCVEValidator(),
+		Osv:         newCVEValidator(),
+		Govulncheck: newGovulncheckValidator(),
+		Semgrep:     newSemgrepValidator(),
+		Gitleaks:    newGitleaksValidator(),
+		Trufflehog:  newTrufflehogValidator(),
+		Syft:        newSyftValidator(),
+		Spdx:        newSpdxValidator(),
+		Sarif:       newSarifValidator(),
+		Zap:         newZapValidator(),
+		Iac:         newIacValidator(),
 	}
+}
 
-	// 2. CVE Allowance - remove from matches
-	ruleGrypeCVEAllow(config, report)
+// newCVEValidator the same rules in the same order for every report with CVEs
+func newCVEValidator() validate.Pipeline[artifacts.Finding, CVERuleConfig] {
+	return validate.NewPipeline(
+		// 1. Deny List and Expired CVE Allowance - fail matching
+		validate.NewValidator[artifacts.Finding, CVERuleConfig]().
+			WithValidationRules(ruleCVEDeny, ruleCVEAcceptanceExpired),
+		// 2. CVE Allowance - remove, then the KEV Catalog Limit
+		validate.NewValidator[artifacts.Finding, CVERuleConfig]().
+			WithNamedAllowRule("cveRiskAcceptance", ruleCVEAllow).
+			WithValidationRules(ruleKEVLimit),
+		// 3. Fix State and EPSS Allowance - remove, then the EPSS, CVSS and Severity Count Limits
+		validate.NewValidator[artifacts.Finding, CVERuleConfig]().
+			WithNamedAllowRule("fixStateRiskAcceptance", ruleFixStateAllow).
+			WithNamedAllowRule("epssRiskAcceptance", ruleEPSSAllow).
+			WithValidationRules(ruleEPSSLimit, ruleCVSSLimit, ruleCVESeverityLimit),
+	)
+}
 
-	// 3. KEV Catalog Limit - fail matching
-	if !ruleGrypeKEVLimit(config, report, catalog) {
-		return newValidationErr("Grype: CVE matched to KEV Catalog")
-	}
+func newGovulncheckValidator() validate.Pipeline[artifacts.GovulncheckVulnerability, *Config] {
+	return validate.NewPipeline(
+		// 1. Deny List and Expired CVE Allowance - fail matching called vulnerabilities
+		validate.NewValidator[artifacts.GovulncheckVulnerability, *Config]().
+			WithValidationRules(ruleGovulncheckCVEDeny, ruleGovulncheckAcceptanceExpired),
+		// 2. CVE Allowance - remove, then the Called Count Limit
+		validate.NewValidator[artifacts.GovulncheckVulnerability, *Config]().
+			WithNamedAllowRule("cveRiskAcceptance", ruleGovulncheckCVEAllow).
+			WithValidationRules(ruleGovulncheckCalledLimit),
+	)
+}
 
-	// 4. EPSS Allowance - remove from matches
-	ruleGrypeEPSSAllow(config, report, data)
+func newSemgrepValidator() validate.Pipeline[artifacts.SemgrepResults, SemgrepRuleConfig] {
+	return validate.NewPipeline(
+		// 1. Scan Error Policy - fail matching scan errors
+		validate.NewValidator[artifacts.SemgrepResults, SemgrepRuleConfig]().
+			WithValidationRules(ruleSemgrepErrorPolicy),
+		// 2. Rule ID, CWE and OWASP Deny Lists - fail matching
+		validate.NewValidator[artifacts.SemgrepResults, SemgrepRuleConfig]().
+			WithValidationRules(ruleSemgrepRuleIDDeny, ruleSemgrepCWEDeny, ruleSemgrepOwaspDeny),
+		// 3. Rule ID, CWE, OWASP, Impact and Computed Risk Allowance - remove, then the Severity and Computed Risk Count Limits
+		validate.NewValidator[artifacts.SemgrepResults, SemgrepRuleConfig]().
+			WithNamedAllowRule("ruleIdRiskAcceptance", ruleSemgrepRuleIDAllow).
+			WithNamedAllowRule("cweRiskAcceptance", ruleSemgrepCWEAllow).
+			WithNamedAllowRule("owaspRiskAcceptance", ruleSemgrepOwaspAllow).
+			WithNamedAllowRule("impactRiskAcceptance", ruleSemgrepImpactRiskAccept).
+			WithNamedAllowRule("riskMatrix.riskAcceptance", ruleSemgrepRiskAccept).
+			WithValidationRules(severityLimitRule((*artifacts.SemgrepResults).Finding, semgrepSeverityLimits), ruleSemgrepRiskLimit),
+	)
+}
 
-	// 5. EPSS Limit - Fail Exceeding TODO: Implement
-	if !ruleGrypeEPSSLimit(config, report, data) {
-		return newValidationErr("Grype: EPSS Limit Exceeded")
-	}
+func newGitleaksValidator() validate.Pipeline[artifacts.GitleaksFinding, *Config] {
+	return validate.NewPipeline(
+		// 1. Allowlist - remove, then the Secrets Limit
+		validate.NewValidator[artifacts.GitleaksFinding, *Config]().
+			WithNamedAllowRule("allowlist", ruleGitleaksAllow).
+			WithValidationRules(ruleGitLeaksLimit),
+	)
+}
 
-	// 6. Severity Count Limit
-	if !ruleGrypeSeverityLimit(config, report) {
-		return newValidationErr("Grype: Severity Limit Exceeded")
-	}
+func newTrufflehogValidator() validate.Pipeline[artifacts.TrufflehogFinding, *Config] {
+	return validate.NewPipeline(
+		// 1. Verified Secrets Limit and Unverified Secrets Limit per Detector
+		validate.NewValidator[artifacts.TrufflehogFinding, *Config]().
+			WithValidationRules(ruleTrufflehogVerifiedLimit, ruleTrufflehogUnverifiedLimit),
+	)
+}
 
-	return nil
+func newSyftValidator() validate.Pipeline[artifacts.SyftPackage, *Config] {
+	return validate.NewPipeline(
+		// 1. Package Deny List and Required Metadata
+		validate.NewValidator[artifacts.SyftPackage, *Config]().
+			WithValidationRules(ruleSyftPackageLimit, ruleSyftRequiredMetadata),
+	)
 }
 
-func validateCyclonedxRules(config *Config, report *artifacts.CyclonedxReportMin, catalog *kev.Catalog, data *epss.Data) error {
-	// 1. Deny List - Fail Matching
-	if !ruleCyclonedxCVEDeny(config, report) {
-		return newValidationErr("CycloneDx: CVE explicitly denied")
-	}
+func newSpdxValidator() validate.Pipeline[artifacts.SpdxPackage, *Config] {
+	return validate.NewPipeline(
+		// 1. Package and License Deny Lists and Required Metadata
+		validate.NewValidator[artifacts.SpdxPackage, *Config]().
+			WithValidationRules(ruleSpdxPackageLimit, ruleSpdxLicenseLimit, ruleSpdxRequiredMetadata),
+	)
+}
 
-	// 2. CVE Allowance - remove from matches
-	ruleCyclonedxCVEAllow(config, report)
+func newSarifValidator() validate.Pipeline[artifacts.SarifFinding, *Config] {
+	return validate.NewPipeline(
+		// 1. Rule ID Deny List - fail matching
+		validate.NewValidator[artifacts.SarifFinding, *Config]().
+			WithValidationRules(ruleSarifRuleIDDeny),
+		// 2. Rule ID Allowance - remove, then the Level and Severity Count Limits
+		validate.NewValidator[artifacts.SarifFinding, *Config]().
+			WithNamedAllowRule("ruleIdRiskAcceptance", ruleSarifRuleIDAllow).
+			WithValidationRules(
+				severityLimitRule(sarifLevelFinding, sarifLevelLimits),
+				severityLimitRule((*artifacts.SarifFinding).Finding, sarifSeverityLimits),
+			),
+	)
+}
 
-	// 3. KEV Catalog Limit - fail matching
-	if !ruleCyclonedxKEVLimit(config, report, catalog) {
-		return newValidationErr("CycloneDx: CVE Matched to KEV Catalog")
-	}
+func newZapValidator() validate.Pipeline[artifacts.ZapAlert, *Config] {
+	return validate.NewPipeline(
+		// 1. Plugin ID Deny List - fail matching
+		validate.NewValidator[artifacts.ZapAlert, *Config]().
+			WithValidationRules(ruleZapPluginIDDeny),
+		// 2. Plugin ID and Confidence Allowance - remove, then the Risk Count Limit
+		validate.NewValidator[artifacts.ZapAlert, *Config]().
+			WithNamedAllowRule("pluginIdRiskAcceptance", ruleZapPluginIDAllow).
+			WithNamedAllowRule("confidenceRiskAcceptance", ruleZapConfidenceRiskAccept).
+			WithValidationRules(severityLimitRule((*artifacts.ZapAlert).Finding, zapRiskLimits)),
+	)
+}
 
-	// 4. EPSS Allowance - remove from matches
-	ruleCyclonedxEPSSAllow(config, report, data)
+func newIacValidator() validate.Pipeline[artifacts.IacFinding, *Config] {
+	return validate.NewPipeline(
+		// 1. Check ID Deny List - fail matching
+		validate.NewValidator[artifacts.IacFinding, *Config]().
+			WithValidationRules(ruleIacCheckIDDeny),
+		// 2. Check ID Allowance - remove, then the Severity Count Limit
+		validate.NewValidator[artifacts.IacFinding, *Config]().
+			WithNamedAllowRule("checkIdRiskAcceptance", ruleIacCheckIDAllow).
+			WithValidationRules(severityLimitRule((*artifacts.IacFinding).Finding, iacSeverityLimits)),
+	)
+}
 
-	// 5. EPSS Limit - Fail Exceeding
-	if !ruleCyclonedxEPSSLimit(config, report, data) {
-		return newValidationErr("CycloneDx: EPSS Limit Exceeded")
+// runValidator the outcome of each object and the failed rules wrapped as a validation failure for the report label
+//
+// id must return the ID the rules give each object in their failed rule errors, unique within the report,
+// SARIF output matches failed rules to findings by ID
+func runValidator[ObjectT any, ConfigT any](label string, validator validate.Pipeline[ObjectT, ConfigT], objects []ObjectT, config ConfigT, id func(ObjectT) string) ([]validate.Outcome, error) {
+	outcomes, err := validator.Explain(objects, config, id)
+	if err != nil {
+		return outcomes, newFailedRulesErr(label, err)
 	}
+	return outcomes, nil
+}
 
-	// 6. Severity Count Limit
-	if !ruleCyclonedxSeverityLimit(config, report) {
-		return newValidationErr("CycloneDx: Severity Limit Exceeded")
+func validateCVERules(label string, validator validate.Pipeline[artifacts.Finding, CVERuleConfig], findings []artifacts.Finding, config CVERuleConfig) ([]validate.Outcome, error) {
+	if config.Config.EPSSRiskAcceptance.Enabled && config.EPSSData == nil {
+		slog.Error("epss allowance enabled but no data exists", "artifact", config.Artifact)
 	}
+	return runValidator(label, validator, findings, config, findingID)
+}
 
-	return nil
+func validateGrypeRules(validator validate.Pipeline[artifacts.Finding, CVERuleConfig], config *Config, report *artifacts.GrypeReportMin, catalog *kev.Catalog, data *epss.Data) ([]validate.Outcome, error) {
+	ruleConfig := CVERuleConfig{Artifact: "grype", Config: config.Grype, Catalog: catalog, EPSSData: data}
+	return validateCVERules("Grype", validator, report.NormalizedFindings(), ruleConfig)
 }
 
-func validateSemgrepRules(config *Config, report *artifacts.SemgrepReportMin) error {
-	// 1. Impact Allowance - remove result
-	ruleSemgrepImpactRiskAccept(config, report)
+func validateCyclonedxRules(validator validate.Pipeline[artifacts.Finding, CVERuleConfig], config *Config, report *artifacts.CyclonedxReportMin, catalog *kev.Catalog, data *epss.Data) ([]validate.Outcome, error) {
+	ruleConfig := CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs, Catalog: catalog, EPSSData: data}
+	findings := report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx())
+	return validateCVERules("CycloneDx", validator, findings, ruleConfig)
+}
+
+func validateTrivyRules(validator validate.Pipeline[artifacts.Finding, CVERuleConfig], config *Config, report *artifacts.TrivyReportMin, catalog *kev.Catalog, data *epss.Data) ([]validate.Outcome, error) {
+	ruleConfig := CVERuleConfig{Artifact: "trivy", Config: config.Trivy, Catalog: catalog, EPSSData: data}
+	return validateCVERules("Trivy", validator, report.NormalizedFindings(), ruleConfig)
+}
 
-	// 2. Severity Count Limit
-	if !ruleSemgrepSeverityLimit(config, report) {
-		return newValidationErr("Semgrep: Severity Limit Exceeded")
+// validateOsvRules OSV records only have CVSS vectors, there is no score for the CVSS limit to compare
+func validateOsvRules(validator validate.Pipeline[artifacts.Finding, CVERuleConfig], config *Config, report *artifacts.OsvReportMin, catalog *kev.Catalog, data *epss.Data) ([]validate.Outcome, error) {
+	if config.Osv.CVSSLimit.Enabled {
+		slog.Warn("cvss limit enabled but osv reports don't include cvss scores, skipping", "artifact", "osv")
 	}
+	ruleConfig := CVERuleConfig{Artifact: "osv", Config: config.Osv, Catalog: catalog, EPSSData: data}
+	return validateCVERules("OSV", validator, report.NormalizedFindings(), ruleConfig)
+}
 
-	return nil
+func validateSemgrepRules(validator validate.Pipeline[artifacts.SemgrepResults, SemgrepRuleConfig], config *Config, report *artifacts.SemgrepReportMin) ([]validate.Outcome, error) {
+	return runValidator("Semgrep", validator, report.Results, SemgrepRuleConfig{Config: config, Errors: report.Errors}, semgrepResultID)
+}
+
+func validateGitleaksRules(validator validate.Pipeline[artifacts.GitleaksFinding, *Config], config *Config, report *artifacts.GitLeaksReportMin) ([]validate.Outcome, error) {
+	return runValidator("Gitleaks", validator, *report, config, gitleaksID)
+}
+
+func validateTrufflehogRules(validator validate.Pipeline[artifacts.TrufflehogFinding, *Config], config *Config, report *artifacts.TrufflehogReportMin) ([]validate.Outcome, error) {
+	return runValidator("TruffleHog", validator, *report, config, trufflehogID)
+}
+
+func validateSyftRules(validator validate.Pipeline[artifacts.SyftPackage, *Config], config *Config, report *artifacts.SyftReportMin) ([]validate.Outcome, error) {
+	return runValidator("Syft", validator, report.Artifacts, config, syftPackageID)
 }
 
-func validateGitleaksRules(config *Config, report *artifacts.GitLeaksReportMin) error {
-	// 1. Limit Secrets - fail
-	if !ruleGitLeaksLimit(config, report) {
-		return newValidationErr("Gitleaks: Secrets Detected")
+func validateGovulncheckRules(validator validate.Pipeline[artifacts.GovulncheckVulnerability, *Config], config *Config, report *artifacts.GovulncheckReportMin) ([]validate.Outcome, error) {
+	if report.Config.ScanLevel != "" && report.Config.ScanLevel != "symbol" {
+		slog.Warn("govulncheck scan level is not symbol, no findings will be called", "scan_level", report.Config.ScanLevel)
 	}
-	return nil
+	return runValidator("govulncheck", validator, report.Vulnerabilities(), config, govulncheckID)
+}
+
+func validateSpdxRules(validator validate.Pipeline[artifacts.SpdxPackage, *Config], config *Config, report *artifacts.SpdxReportMin) ([]validate.Outcome, error) {
+	return runValidator("SPDX", validator, report.Packages, config, spdxPackageID)
+}
+
+func validateSarifRules(validator validate.Pipeline[artifacts.SarifFinding, *Config], config *Config, report *artifacts.SarifReportMin) ([]validate.Outcome, error) {
+	return runValidator("SARIF", validator, report.Findings(), config, sarifFindingID)
+}
+
+func validateZapRules(validator validate.Pipeline[artifacts.ZapAlert, *Config], config *Config, report *artifacts.ZapReportMin) ([]validate.Outcome, error) {
+	return runValidator("ZAP", validator, report.AllAlerts(), config, zapAlertID)
+}
+
+func validateIacRules(validator validate.Pipeline[artifacts.IacFinding, *Config], config *Config, report *artifacts.IacReportMin) ([]validate.Outcome, error) {
+	slog.Debug("validate iac findings", "tool", report.Tool)
+	return runValidator("IaC", validator, report.Findings, config, iacFindingID)
 }
@@ -1,12 +1,20 @@
 package gatecheck
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"log/slog"
 	"os"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gatecheckdev/gatecheck/pkg/artifacts"
+	"github.com/gatecheckdev/gatecheck/pkg/epss"
+	"github.com/gatecheckdev/gatecheck/pkg/kev"
+	"github.com/gatecheckdev/gatecheck/pkg/validate"
 	"github.com/lmittmann/tint"
 )
 
@@ -20,13 +28,18 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
+// allowedFindings the findings left after an allow rule
+func allowedFindings(findings []artifacts.Finding, config CVERuleConfig, rule func(artifacts.Finding, CVERuleConfig) bool) []artifacts.Finding {
+	return validate.NewValidator[artifacts.Finding, CVERuleConfig]().WithAllowRules(rule).Filter(findings, config)
+}
+
 func Test_ruleGrypeSeverityLimit(t *testing.T) {
 	t.Run("empty-report-empty-config", func(t *testing.T) {
 		config := new(Config)
 		report := new(artifacts.GrypeReportMin)
 
 		want := true
-		got := ruleGrypeSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -40,7 +53,7 @@ func Test_ruleGrypeSeverityLimit(t *testing.T) {
 		report := new(artifacts.GrypeReportMin)
 
 		want := true
-		got := ruleGrypeSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -57,7 +70,7 @@ func Test_ruleGrypeSeverityLimit(t *testing.T) {
 		}
 
 		want := false
-		got := ruleGrypeSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -84,7 +97,7 @@ func Test_ruleGrypeSeverityLimit(t *testing.T) {
 		}
 
 		want := false
-		got := ruleGrypeSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -111,7 +124,7 @@ func Test_ruleGrypeSeverityLimit(t *testing.T) {
 		}
 
 		want := true
-		got := ruleGrypeSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -138,7 +151,7 @@ func Test_ruleGrypeSeverityLimit(t *testing.T) {
 		}
 
 		want := false
-		got := ruleGrypeSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -158,7 +171,7 @@ func Test_ruleGrypeSeverityLimit(t *testing.T) {
 
 		want := true
 		got := false
-		err := validateGrypeRules(config, report, nil, nil)
+		_, err := validateGrypeRules(DefaultValidators().Grype, config, report, nil, nil)
 		if err == nil {
 			got = true
 		}
@@ -169,13 +182,64 @@ func Test_ruleGrypeSeverityLimit(t *testing.T) {
 	})
 }
 
+func Test_ruleCVERiskAcceptanceExpired(t *testing.T) {
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(time.DateOnly)
+	newReport := func() *artifacts.GrypeReportMin {
+		return &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+			{Vulnerability: artifacts.GrypeVulnerability{Severity: "critical", ID: "cve-1"}},
+		}}
+	}
+
+	testTable := []struct {
+		label   string
+		cve     configCVE
+		wantErr error
+	}{
+		{label: "no-expiration", cve: configCVE{ID: "cve-1"}, wantErr: nil},
+		{label: "not-expired", cve: configCVE{ID: "CVE-1", ExpiresAt: tomorrow}, wantErr: nil},
+		{label: "expired-date", cve: configCVE{ID: "cve-1", ExpiresAt: "2020-01-01"}, wantErr: ErrValidationFailure},
+		{label: "expired-timestamp", cve: configCVE{ID: "cve-1", ExpiresAt: "2020-01-01T12:00:00Z"}, wantErr: ErrValidationFailure},
+		{label: "invalid-expiration", cve: configCVE{ID: "cve-1", ExpiresAt: "next week"}, wantErr: ErrValidationFailure},
+		{label: "expired-not-reported", cve: configCVE{ID: "cve-2", ExpiresAt: "2020-01-01"}, wantErr: nil},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.label, func(t *testing.T) {
+			config := new(Config)
+			config.Grype.SeverityLimit.Critical.Enabled = true
+			config.Grype.SeverityLimit.Critical.Limit = 1
+			config.Grype.CVERiskAcceptance.Enabled = true
+			config.Grype.CVERiskAcceptance.CVEs = []configCVE{testCase.cve}
+
+			_, err := validateGrypeRules(DefaultValidators().Grype, config, newReport(), nil, nil)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("want: %v got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("expired-stops-suppressing", func(t *testing.T) {
+		config := new(Config)
+		config.Trivy.CVERiskAcceptance.Enabled = true
+		config.Trivy.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-1", ExpiresAt: "2020-01-01"}}
+		report := &artifacts.TrivyReportMin{Results: []artifacts.TrivyResult{
+			{Vulnerabilities: []artifacts.TrivyVulnerability{{VulnerabilityID: "CVE-1", Severity: "CRITICAL"}}},
+		}}
+
+		findings := allowedFindings(report.NormalizedFindings(), CVERuleConfig{Artifact: "trivy", Config: config.Trivy}, ruleCVEAllow)
+		if got := len(findings); got != 1 {
+			t.Fatalf("want: 1 vulnerability got: %d", got)
+		}
+	})
+}
+
 func Test_ruleCyclonedxSeverityLimit(t *testing.T) {
 	t.Run("empty-report-empty-config", func(t *testing.T) {
 		config := new(Config)
 		report := new(artifacts.CyclonedxReportMin)
 
 		want := true
-		got := ruleCyclonedxSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -189,7 +253,7 @@ func Test_ruleCyclonedxSeverityLimit(t *testing.T) {
 		report := new(artifacts.CyclonedxReportMin)
 
 		want := true
-		got := ruleCyclonedxSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -206,7 +270,7 @@ func Test_ruleCyclonedxSeverityLimit(t *testing.T) {
 		}
 
 		want := false
-		got := ruleCyclonedxSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -233,7 +297,7 @@ func Test_ruleCyclonedxSeverityLimit(t *testing.T) {
 		}
 
 		want := false
-		got := ruleCyclonedxSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -260,7 +324,7 @@ func Test_ruleCyclonedxSeverityLimit(t *testing.T) {
 		}
 
 		want := true
-		got := ruleCyclonedxSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -287,7 +351,7 @@ func Test_ruleCyclonedxSeverityLimit(t *testing.T) {
 		}
 
 		want := false
-		got := ruleCyclonedxSeverityLimit(config, report)
+		got := ruleCVESeverityLimit(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -310,7 +374,7 @@ func Test_ruleCyclonedxSeverityLimit(t *testing.T) {
 
 		want := true
 		got := false
-		err := validateCyclonedxRules(config, report, nil, nil)
+		_, err := validateCyclonedxRules(DefaultValidators().Cyclonedx, config, report, nil, nil)
 		if err == nil {
 			got = true
 		}
@@ -321,14 +385,153 @@ func Test_ruleCyclonedxSeverityLimit(t *testing.T) {
 	})
 }
 
-func Test_ruleSemgrepSeverityLimit(t *testing.T) {
+func Test_validateCyclonedxFrom_xml(t *testing.T) {
+	newSrc := func(t *testing.T) *os.File {
+		f, err := os.Open("../../test/cyclonedx-maven-bom.xml")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { f.Close() })
+		return f
+	}
+
+	t.Run("decode", func(t *testing.T) {
+		report := &artifacts.CyclonedxReportMin{}
+		if err := artifacts.DecodeCyclonedx(newSrc(t), report); err != nil {
+			t.Fatal(err)
+		}
+		if len(report.Components) != 3 || len(report.Vulnerabilities) != 3 {
+			t.Fatalf("want 3 components and 3 vulnerabilities got: %d %d", len(report.Components), len(report.Vulnerabilities))
+		}
+		if got := report.AffectedPackages(0); got != "log4j-core [2.14.1]" {
+			t.Fatalf("want: log4j-core [2.14.1] got: %s", got)
+		}
+		if got := report.Vulnerabilities[1].HighestSeverity(); got != "high" {
+			t.Fatalf("want: high got: %s", got)
+		}
+	})
+
+	t.Run("kev-limit", func(t *testing.T) {
+		config := new(Config)
+		config.Cyclonedx.KEVLimitEnabled = true
+		catalog := kev.NewCatalog()
+		catalog.Vulnerabilities = []kev.Vulnerability{{CveID: "CVE-2021-44228"}}
+
+		err := validateCyclonedxFrom(newSrc(t), config, catalog, new(epss.Data), DefaultValidators())
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("cvss-limit", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Cyclonedx.CVSSLimit.Enabled = true
+		config.Cyclonedx.CVSSLimit.Score = 9.9
+
+		err := validateCyclonedxFrom(newSrc(t), config, kev.NewCatalog(), new(epss.Data), DefaultValidators())
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+
+		config.Cyclonedx.CVSSLimit.Score = 10
+		if err := validateCyclonedxFrom(newSrc(t), config, kev.NewCatalog(), new(epss.Data), DefaultValidators()); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("severity-limit-accepted", func(t *testing.T) {
+		config := new(Config)
+		config.Cyclonedx.SeverityLimit.Critical.Enabled = true
+		config.Cyclonedx.SeverityLimit.Critical.Limit = 0
+		config.Cyclonedx.CVERiskAcceptance.Enabled = true
+		config.Cyclonedx.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-2021-44228"}}
+
+		if err := validateCyclonedxFrom(newSrc(t), config, kev.NewCatalog(), new(epss.Data), DefaultValidators()); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func Test_ruleSeverityLimit(t *testing.T) {
+	config := new(Config)
+	config.Sarif.LevelLimit.Error = configLimit{Enabled: true, Limit: 0}
+	config.Zap.RiskLimit.High = configLimit{Enabled: true, Limit: 0}
+	config.Iac.SeverityLimit.Critical = configLimit{Enabled: true, Limit: 0}
+
+	testTable := []struct {
+		name    string
+		run     func() error
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "sarif-level",
+			run: func() error {
+				findings := []artifacts.SarifFinding{
+					{RuleID: "G101", Level: "error", Severity: "high", Location: "main.go:1"},
+					{RuleID: "G101", Level: "warning", Severity: "high", Location: "main.go:2"},
+				}
+				return severityLimitRule(sarifLevelFinding, sarifLevelLimits)(findings, config)
+			},
+			want:    []string{"sarif.levelLimit.error: G101 main.go:1"},
+			notWant: []string{"main.go:2"},
+		},
+		{
+			name: "zap-risk",
+			run: func() error {
+				alerts := []artifacts.ZapAlert{
+					{PluginID: "10038", RiskCode: "3", Instances: []artifacts.ZapInstance{{URI: "https://example.com/a"}}},
+					{PluginID: "10038", RiskCode: "3", Instances: []artifacts.ZapInstance{{URI: "https://example.com/b"}}},
+					{PluginID: "10020", RiskCode: "2"},
+				}
+				return severityLimitRule((*artifacts.ZapAlert).Finding, zapRiskLimits)(alerts, config)
+			},
+			want:    []string{"zap.riskLimit.high: 10038 https://example.com/a", "zap.riskLimit.high: 10038 https://example.com/b"},
+			notWant: []string{"10020"},
+		},
+		{
+			name: "iac-unknown-not-limited",
+			run: func() error {
+				findings := []artifacts.IacFinding{{CheckID: "CKV_AWS_18", Severity: "unknown", File: "main.tf"}}
+				return severityLimitRule((*artifacts.IacFinding).Finding, iacSeverityLimits)(findings, config)
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.run()
+			if len(testCase.want) == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !errors.Is(err, validate.ErrFailedRule) {
+				t.Fatalf("want: %v got: %v", validate.ErrFailedRule, err)
+			}
+			for _, want := range testCase.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Fatalf("want: %q in %q", want, err.Error())
+				}
+			}
+			for _, notWant := range testCase.notWant {
+				if strings.Contains(err.Error(), notWant) {
+					t.Fatalf("did not want: %q in %q", notWant, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func Test_semgrepSeverityLimits(t *testing.T) {
 	t.Run("empty-report-empty-config", func(t *testing.T) {
 		config := new(Config)
 		report := new(artifacts.SemgrepReportMin)
 
 		want := true
 
-		got := ruleSemgrepSeverityLimit(config, report)
+		got := ruleSeverityLimit(report.NormalizedFindings(), semgrepSeverityLimits(SemgrepRuleConfig{Config: config})) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -343,7 +546,7 @@ func Test_ruleSemgrepSeverityLimit(t *testing.T) {
 
 		want := true
 
-		got := ruleSemgrepSeverityLimit(config, report)
+		got := ruleSeverityLimit(report.NormalizedFindings(), semgrepSeverityLimits(SemgrepRuleConfig{Config: config})) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -371,7 +574,7 @@ func Test_ruleSemgrepSeverityLimit(t *testing.T) {
 
 		want := false
 
-		got := ruleSemgrepSeverityLimit(config, report)
+		got := ruleSeverityLimit(report.NormalizedFindings(), semgrepSeverityLimits(SemgrepRuleConfig{Config: config})) == nil
 
 		if want != got {
 			t.Fatalf("want: %t got: %t", want, got)
@@ -396,7 +599,7 @@ func Test_ruleSemgrepSeverityLimit(t *testing.T) {
 		want := true
 		got := true
 
-		err := validateSemgrepRules(config, report)
+		_, err := validateSemgrepRules(DefaultValidators().Semgrep, config, report)
 		if err != nil {
 			got = false
 		}
@@ -425,7 +628,7 @@ func Test_ruleSemgrepSeverityLimit(t *testing.T) {
 		want := false
 		got := true
 
-		err := validateSemgrepRules(config, report)
+		_, err := validateSemgrepRules(DefaultValidators().Semgrep, config, report)
 		if err != nil {
 			got = false
 		}
@@ -435,3 +638,1448 @@ func Test_ruleSemgrepSeverityLimit(t *testing.T) {
 		}
 	})
 }
+
+func Test_ruleSyftPackageLimit(t *testing.T) {
+	report := &artifacts.SyftReportMin{
+		Artifacts: []artifacts.SyftPackage{
+			{Name: "log4j-core", Version: "2.14.1", Type: "java-archive"},
+			{Name: "openssl", Version: "3.0.2", Type: "deb"},
+		},
+	}
+
+	testTable := []struct {
+		name     string
+		packages []configPackage
+		want     bool
+	}{
+		{name: "no-match", packages: []configPackage{{Name: "lodash"}}, want: true},
+		{name: "name-match", packages: []configPackage{{Name: "Log4j-Core"}}, want: false},
+		{name: "version-match", packages: []configPackage{{Name: "openssl", Version: "3.0.2"}}, want: false},
+		{name: "version-mismatch", packages: []configPackage{{Name: "openssl", Version: "3.0.7"}}, want: true},
+		{name: "type-mismatch", packages: []configPackage{{Name: "openssl", Type: "apk"}}, want: true},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := new(Config)
+			config.Syft.PackageLimit.Enabled = true
+			config.Syft.PackageLimit.Packages = testCase.packages
+			got := ruleSyftPackageLimit(report.Artifacts, config) == nil
+			if got != testCase.want {
+				t.Fatalf("want: %t got: %t", testCase.want, got)
+			}
+		})
+	}
+
+	t.Run("not-enabled", func(t *testing.T) {
+		config := new(Config)
+		config.Syft.PackageLimit.Packages = []configPackage{{Name: "openssl"}}
+		if ruleSyftPackageLimit(report.Artifacts, config) != nil {
+			t.Fatal("want: true got: false")
+		}
+	})
+}
+
+func Test_validateSyftReport(t *testing.T) {
+	reportContent := `{
+  "descriptor": {"name": "syft", "version": "1.0.0"},
+  "artifacts": [
+    {"name": "musl", "version": "1.2.4", "type": "apk", "purl": "pkg:apk/alpine/musl@1.2.4", "licenses": ["MIT"]},
+    {"name": "zlib", "version": "1.3", "type": "apk", "purl": "pkg:apk/alpine/zlib@1.3", "licenses": [{"value": "Zlib", "spdxExpression": "Zlib"}]},
+    {"name": "internal-lib", "version": "0.1.0", "type": "go-module", "purl": "", "licenses": []}
+  ]
+}`
+
+	t.Run("required-version-pass", func(t *testing.T) {
+		config := new(Config)
+		config.Syft.RequiredMetadata.Enabled = true
+		config.Syft.RequiredMetadata.Version = true
+		config.Syft.PackageLimit.Enabled = true
+		config.Syft.PackageLimit.Packages = []configPackage{{Name: "openssl"}}
+		err := validateSyftReport(strings.NewReader(reportContent), config, DefaultValidators())
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("required-license-pass", func(t *testing.T) {
+		licensedContent := `{
+  "descriptor": {"name": "syft", "version": "1.0.0"},
+  "artifacts": [
+    {"name": "musl", "version": "1.2.4", "type": "apk", "purl": "pkg:apk/alpine/musl@1.2.4", "licenses": ["MIT"]},
+    {"name": "zlib", "version": "1.3", "type": "apk", "purl": "pkg:apk/alpine/zlib@1.3", "licenses": [{"value": "Zlib", "spdxExpression": "Zlib"}]}
+  ]
+}`
+		config := new(Config)
+		config.Syft.RequiredMetadata.Enabled = true
+		config.Syft.RequiredMetadata.License = true
+		err := validateSyftReport(strings.NewReader(licensedContent), config, DefaultValidators())
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("required-license-fail", func(t *testing.T) {
+		config := new(Config)
+		config.Syft.RequiredMetadata.Enabled = true
+		config.Syft.RequiredMetadata.License = true
+		err := validateSyftReport(strings.NewReader(reportContent), config, DefaultValidators())
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+		if !strings.Contains(err.Error(), "internal-lib") {
+			t.Fatalf("want internal-lib in error got: %v", err)
+		}
+	})
+
+	t.Run("required-purl-fail", func(t *testing.T) {
+		config := new(Config)
+		config.Syft.RequiredMetadata.Enabled = true
+		config.Syft.RequiredMetadata.PURL = true
+		err := validateSyftReport(strings.NewReader(reportContent), config, DefaultValidators())
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("decode-failure", func(t *testing.T) {
+		err := validateSyftReport(strings.NewReader("{{"), new(Config), DefaultValidators())
+		if err == nil {
+			t.Fatal("want error got nil")
+		}
+	})
+}
+
+func Test_validateOsvRules(t *testing.T) {
+	newReport := func(t *testing.T) *artifacts.OsvReportMin {
+		f, err := os.Open("../../test/osv-scanner-report.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.OsvReportMin{}
+		if err := json.NewDecoder(f).Decode(report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	t.Run("severity-limit", func(t *testing.T) {
+		config := new(Config)
+		config.Osv.SeverityLimit.Critical.Enabled = true
+		config.Osv.SeverityLimit.Critical.Limit = 0
+		_, err := validateOsvRules(DefaultValidators().Osv, config, newReport(t), nil, nil)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("cve-deny-by-alias", func(t *testing.T) {
+		config := new(Config)
+		config.Osv.CVELimit.Enabled = true
+		config.Osv.CVELimit.CVEs = []configCVE{{ID: "CVE-2021-23337"}}
+		_, err := validateOsvRules(DefaultValidators().Osv, config, newReport(t), nil, nil)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("cve-accept-by-alias", func(t *testing.T) {
+		config := new(Config)
+		config.Osv.SeverityLimit.Critical.Enabled = true
+		config.Osv.SeverityLimit.Critical.Limit = 0
+		config.Osv.CVERiskAcceptance.Enabled = true
+		config.Osv.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-2020-14343"}}
+		if _, err := validateOsvRules(DefaultValidators().Osv, config, newReport(t), nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("kev-limit-by-alias", func(t *testing.T) {
+		config := new(Config)
+		config.Osv.KEVLimitEnabled = true
+		catalog := kev.NewCatalog()
+		catalog.Vulnerabilities = []kev.Vulnerability{{CveID: "CVE-2021-23337"}}
+		_, err := validateOsvRules(DefaultValidators().Osv, config, newReport(t), catalog, nil)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("epss-limit-by-alias", func(t *testing.T) {
+		config := new(Config)
+		config.Osv.EPSSLimit.Enabled = true
+		config.Osv.EPSSLimit.Score = 0.5
+		data := &epss.Data{CVEs: map[string]epss.CVE{"CVE-2020-28500": {EPSS: "0.9", Percentile: "0.99"}}}
+		_, err := validateOsvRules(DefaultValidators().Osv, config, newReport(t), nil, data)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+}
+
+func Test_validateGovulncheckRules(t *testing.T) {
+	newReport := func(t *testing.T) *artifacts.GovulncheckReportMin {
+		f, err := os.Open("../../test/govulncheck-report.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.GovulncheckReportMin{}
+		if err := artifacts.DecodeGovulncheck(f, report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	t.Run("decode-levels", func(t *testing.T) {
+		report := newReport(t)
+		byLevel := map[string][]artifacts.GovulncheckVulnerability{}
+		for _, vulnerability := range report.Vulnerabilities() {
+			byLevel[vulnerability.Level] = append(byLevel[vulnerability.Level], vulnerability)
+		}
+		for _, level := range []string{artifacts.GovulncheckLevelCalled, artifacts.GovulncheckLevelImported, artifacts.GovulncheckLevelRequired} {
+			if got := len(byLevel[level]); got != 1 {
+				t.Fatalf("level: %s want: 1 got: %d", level, got)
+			}
+		}
+		called := byLevel[artifacts.GovulncheckLevelCalled][0]
+		if called.Symbol != "(*Server).ListenAndServe" || called.Call.PositionShort() != "cmd/api/main.go:27" {
+			t.Fatalf("unexpected call: %s %s", called.Symbol, called.Call.PositionShort())
+		}
+		if called.OSV.CVE() != "CVE-2023-45288" {
+			t.Fatalf("want: CVE-2023-45288 got: %s", called.OSV.CVE())
+		}
+	})
+
+	t.Run("normalized-findings", func(t *testing.T) {
+		report := newReport(t)
+		findings := report.NormalizedFindings()
+		if len(findings) != len(report.Vulnerabilities()) {
+			t.Fatalf("want: %d got: %d", len(report.Vulnerabilities()), len(findings))
+		}
+		i := slices.IndexFunc(findings, func(finding artifacts.Finding) bool { return finding.Location != "" })
+		if i < 0 || findings[i].Location != "cmd/api/main.go:27" || findings[i].CVE() != "CVE-2023-45288" {
+			t.Fatalf("want the called vulnerability located at the call got: %+v", findings)
+		}
+	})
+
+	t.Run("called-limit", func(t *testing.T) {
+		config := new(Config)
+		config.Govulncheck.CalledLimit.Enabled = true
+		config.Govulncheck.CalledLimit.Limit = 0
+		_, err := validateGovulncheckRules(DefaultValidators().Govulncheck, config, newReport(t))
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("called-limit-accepted", func(t *testing.T) {
+		config := new(Config)
+		config.Govulncheck.CalledLimit.Enabled = true
+		config.Govulncheck.CalledLimit.Limit = 0
+		config.Govulncheck.CVERiskAcceptance.Enabled = true
+		config.Govulncheck.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-2023-45288"}}
+		if _, err := validateGovulncheckRules(DefaultValidators().Govulncheck, config, newReport(t)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("deny-imported-is-informational", func(t *testing.T) {
+		config := new(Config)
+		config.Govulncheck.CVELimit.Enabled = true
+		config.Govulncheck.CVELimit.CVEs = []configCVE{{ID: "GO-2023-2402"}, {ID: "CVE-2024-24786"}}
+		if _, err := validateGovulncheckRules(DefaultValidators().Govulncheck, config, newReport(t)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("deny-called", func(t *testing.T) {
+		config := new(Config)
+		config.Govulncheck.CVELimit.Enabled = true
+		config.Govulncheck.CVELimit.CVEs = []configCVE{{ID: "GHSA-4v7x-pqxf-cx7m"}}
+		_, err := validateGovulncheckRules(DefaultValidators().Govulncheck, config, newReport(t))
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+}
+
+func Test_validateSpdxRules(t *testing.T) {
+	newReport := func(t *testing.T) *artifacts.SpdxReportMin {
+		f, err := os.Open("../../test/spdx-sbom.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.SpdxReportMin{}
+		if err := json.NewDecoder(f).Decode(report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	testTable := []struct {
+		name    string
+		config  func(*Config)
+		wantErr bool
+	}{
+		{name: "empty-config", config: func(_ *Config) {}, wantErr: false},
+		{name: "package-denied-purl-type", config: func(c *Config) {
+			c.Spdx.PackageLimit.Enabled = true
+			c.Spdx.PackageLimit.Packages = []configPackage{{Name: "lodash", Type: "npm"}}
+		}, wantErr: true},
+		{name: "package-other-type", config: func(c *Config) {
+			c.Spdx.PackageLimit.Enabled = true
+			c.Spdx.PackageLimit.Packages = []configPackage{{Name: "lodash", Type: "pypi"}}
+		}, wantErr: false},
+		{name: "license-denied-in-expression", config: func(c *Config) {
+			c.Spdx.LicenseLimit.Enabled = true
+			c.Spdx.LicenseLimit.Licenses = []string{"gpl-2.0-only"}
+		}, wantErr: true},
+		{name: "license-not-present", config: func(c *Config) {
+			c.Spdx.LicenseLimit.Enabled = true
+			c.Spdx.LicenseLimit.Licenses = []string{"AGPL-3.0-only"}
+		}, wantErr: false},
+		{name: "required-license", config: func(c *Config) {
+			c.Spdx.RequiredMetadata.Enabled = true
+			c.Spdx.RequiredMetadata.License = true
+		}, wantErr: true},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := new(Config)
+			testCase.config(config)
+			_, err := validateSpdxRules(DefaultValidators().Spdx, config, newReport(t))
+			if testCase.wantErr && !errors.Is(err, ErrValidationFailure) {
+				t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+			}
+			if !testCase.wantErr && err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	t.Run("purl-from-external-refs", func(t *testing.T) {
+		report := newReport(t)
+		if got := report.Packages[1].PURL(); got != "pkg:npm/lodash@4.17.21" {
+			t.Fatalf("want: pkg:npm/lodash@4.17.21 got: %s", got)
+		}
+		if got := len(report.RelationshipsFrom("SPDXRef-Package-demo-service", "DEPENDS_ON")); got != 3 {
+			t.Fatalf("want: 3 got: %d", got)
+		}
+	})
+}
+
+func Test_validateTrivyRules(t *testing.T) {
+	newReport := func() *artifacts.TrivyReportMin {
+		return &artifacts.TrivyReportMin{
+			Results: []artifacts.TrivyResult{
+				{
+					Target: "alpine",
+					Vulnerabilities: []artifacts.TrivyVulnerability{
+						{VulnerabilityID: "cve-1", Severity: "CRITICAL"},
+						{VulnerabilityID: "cve-2", Severity: "HIGH"},
+					},
+				},
+				{
+					Target: "package-lock.json",
+					Vulnerabilities: []artifacts.TrivyVulnerability{
+						{VulnerabilityID: "cve-3", Severity: "CRITICAL"},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("severity-limit-exceeded", func(t *testing.T) {
+		config := new(Config)
+		config.Trivy.SeverityLimit.Critical.Enabled = true
+		config.Trivy.SeverityLimit.Critical.Limit = 1
+		_, err := validateTrivyRules(DefaultValidators().Trivy, config, newReport(), nil, nil)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("severity-limit-accepted", func(t *testing.T) {
+		config := new(Config)
+		config.Trivy.SeverityLimit.Critical.Enabled = true
+		config.Trivy.SeverityLimit.Critical.Limit = 1
+		config.Trivy.CVERiskAcceptance.Enabled = true
+		config.Trivy.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-3"}}
+		_, err := validateTrivyRules(DefaultValidators().Trivy, config, newReport(), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("cve-denied", func(t *testing.T) {
+		config := new(Config)
+		config.Trivy.CVELimit.Enabled = true
+		config.Trivy.CVELimit.CVEs = []configCVE{{ID: "cve-2"}}
+		_, err := validateTrivyRules(DefaultValidators().Trivy, config, newReport(), nil, nil)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("kev-limit", func(t *testing.T) {
+		config := new(Config)
+		config.Trivy.KEVLimitEnabled = true
+		catalog := kev.NewCatalog()
+		catalog.Vulnerabilities = append(catalog.Vulnerabilities, kev.Vulnerability{CveID: "cve-3"})
+		_, err := validateTrivyRules(DefaultValidators().Trivy, config, newReport(), catalog, nil)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("epss-limit-and-acceptance", func(t *testing.T) {
+		config := new(Config)
+		config.Trivy.EPSSRiskAcceptance.Enabled = true
+		config.Trivy.EPSSRiskAcceptance.Score = 0.5
+		config.Trivy.EPSSLimit.Enabled = true
+		config.Trivy.EPSSLimit.Score = 0.8
+		data := &epss.Data{CVEs: map[string]epss.CVE{
+			"cve-1": {EPSS: "0.1", Percentile: "0.5"},
+			"cve-3": {EPSS: "0.9", Percentile: "0.99"},
+		}}
+		_, err := validateTrivyRules(DefaultValidators().Trivy, config, newReport(), nil, data)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+}
+
+func Test_validateSarifRules(t *testing.T) {
+	newReport := func(t *testing.T) *artifacts.SarifReportMin {
+		f, err := os.Open("../../test/sarif-report.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.SarifReportMin{}
+		if err := json.NewDecoder(f).Decode(report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	t.Run("levels-and-severities", func(t *testing.T) {
+		report := newReport(t)
+		levels, severities := map[string]int{}, map[string]int{}
+		for _, finding := range report.Findings() {
+			levels[finding.Level]++
+			severities[finding.Severity]++
+		}
+		wantLevels := map[string]int{"error": 2, "warning": 1, "note": 1}
+		for level, want := range wantLevels {
+			if got := levels[level]; got != want {
+				t.Fatalf("level %s want: %d got: %d", level, want, got)
+			}
+		}
+		// security-severity 8.8, 7.8 map to high, gosec error without a score maps to high
+		wantSeverities := map[string]int{"critical": 0, "high": 3, "medium": 0, "low": 1}
+		for severity, want := range wantSeverities {
+			if got := severities[severity]; got != want {
+				t.Fatalf("severity %s want: %d got: %d", severity, want, got)
+			}
+		}
+	})
+
+	testTable := []struct {
+		name   string
+		config func(*Config)
+		want   error
+	}{
+		{name: "empty-config", config: func(_ *Config) {}, want: nil},
+		{
+			name: "level-limit-exceeded",
+			config: func(c *Config) {
+				c.Sarif.LevelLimit.Error.Enabled = true
+				c.Sarif.LevelLimit.Error.Limit = 1
+			},
+			want: ErrValidationFailure,
+		},
+		{
+			name: "level-limit-accepted",
+			config: func(c *Config) {
+				c.Sarif.LevelLimit.Error.Enabled = true
+				c.Sarif.LevelLimit.Error.Limit = 1
+				c.Sarif.RuleIDRiskAcceptance.Enabled = true
+				c.Sarif.RuleIDRiskAcceptance.RuleIDs = []configRuleID{{ID: "G401"}}
+			},
+			want: nil,
+		},
+		{
+			name: "severity-limit-exceeded",
+			config: func(c *Config) {
+				c.Sarif.SeverityLimit.High.Enabled = true
+				c.Sarif.SeverityLimit.High.Limit = 2
+			},
+			want: ErrValidationFailure,
+		},
+		{
+			name: "rule-id-denied",
+			config: func(c *Config) {
+				c.Sarif.RuleIDLimit.Enabled = true
+				c.Sarif.RuleIDLimit.RuleIDs = []configRuleID{{ID: "go/sql-injection"}}
+			},
+			want: ErrValidationFailure,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := new(Config)
+			testCase.config(config)
+			_, err := validateSarifRules(DefaultValidators().Sarif, config, newReport(t))
+			if !errors.Is(err, testCase.want) {
+				t.Fatalf("want: %v got: %v", testCase.want, err)
+			}
+		})
+	}
+}
+
+func Test_validateZapRules(t *testing.T) {
+	newReport := func(t *testing.T) *artifacts.ZapReportMin {
+		f, err := os.Open("../../test/zap-report.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.ZapReportMin{}
+		if err := json.NewDecoder(f).Decode(report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	testTable := []struct {
+		name    string
+		setup   func(config *Config)
+		wantErr error
+	}{
+		{name: "default", setup: func(config *Config) {}, wantErr: nil},
+		{
+			name: "risk-limit-high",
+			setup: func(config *Config) {
+				config.Zap.RiskLimit.High = configLimit{Enabled: true, Limit: 0}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "risk-limit-low-within",
+			setup: func(config *Config) {
+				config.Zap.RiskLimit.Low = configLimit{Enabled: true, Limit: 1}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "plugin-id-deny",
+			setup: func(config *Config) {
+				config.Zap.PluginIDLimit.Enabled = true
+				config.Zap.PluginIDLimit.PluginIDs = []configPluginID{{ID: "10038"}}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "plugin-id-accepted",
+			setup: func(config *Config) {
+				config.Zap.RiskLimit.High = configLimit{Enabled: true, Limit: 0}
+				config.Zap.PluginIDRiskAcceptance.Enabled = true
+				config.Zap.PluginIDRiskAcceptance.PluginIDs = []configPluginID{{ID: "40012"}}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "confidence-accepted",
+			setup: func(config *Config) {
+				config.Zap.RiskLimit.High = configLimit{Enabled: true, Limit: 0}
+				config.Zap.ConfidenceRiskAcceptance.Enabled = true
+				config.Zap.ConfidenceRiskAcceptance.Medium = true
+			},
+			wantErr: nil,
+		},
+		{
+			name: "confidence-not-accepted",
+			setup: func(config *Config) {
+				config.Zap.RiskLimit.Medium = configLimit{Enabled: true, Limit: 0}
+				config.Zap.ConfidenceRiskAcceptance.Enabled = true
+				config.Zap.ConfidenceRiskAcceptance.Medium = true
+			},
+			wantErr: ErrValidationFailure,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := NewDefaultConfig()
+			testCase.setup(config)
+			_, err := validateZapRules(DefaultValidators().Zap, config, newReport(t))
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("want: %v got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func Test_validateIacRules(t *testing.T) {
+	newReport := func(t *testing.T, filename string) *artifacts.IacReportMin {
+		f, err := os.Open(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.IacReportMin{}
+		decode := artifacts.DecodeTfsec
+		if strings.Contains(filename, "checkov") {
+			decode = artifacts.DecodeCheckov
+		}
+		if err := decode(f, report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	testTable := []struct {
+		name     string
+		filename string
+		setup    func(config *Config)
+		wantErr  error
+	}{
+		{name: "checkov-default", filename: "../../test/checkov-report.json", setup: func(config *Config) {}, wantErr: nil},
+		{
+			name:     "checkov-severity-limit",
+			filename: "../../test/checkov-report.json",
+			setup: func(config *Config) {
+				config.Iac.SeverityLimit.High = configLimit{Enabled: true, Limit: 1}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name:     "checkov-accepted-by-resource-glob",
+			filename: "../../test/checkov-report.json",
+			setup: func(config *Config) {
+				config.Iac.SeverityLimit.High = configLimit{Enabled: true, Limit: 1}
+				config.Iac.CheckIDRiskAcceptance.Enabled = true
+				config.Iac.CheckIDRiskAcceptance.CheckIDs = []configCheckID{{ID: "CKV_AWS_145", Resource: "module.logs.*"}}
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "checkov-accepted-other-resource",
+			filename: "../../test/checkov-report.json",
+			setup: func(config *Config) {
+				config.Iac.SeverityLimit.High = configLimit{Enabled: true, Limit: 0}
+				config.Iac.CheckIDRiskAcceptance.Enabled = true
+				config.Iac.CheckIDRiskAcceptance.CheckIDs = []configCheckID{{ID: "CKV_AWS_145", Resource: "module.logs.*"}}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name:     "tfsec-deny",
+			filename: "../../test/tfsec-report.json",
+			setup: func(config *Config) {
+				config.Iac.CheckIDLimit.Enabled = true
+				config.Iac.CheckIDLimit.CheckIDs = []configCheckID{{ID: "avd-aws-0089"}}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name:     "tfsec-deny-resource-not-matched",
+			filename: "../../test/tfsec-report.json",
+			setup: func(config *Config) {
+				config.Iac.CheckIDLimit.Enabled = true
+				config.Iac.CheckIDLimit.CheckIDs = []configCheckID{{ID: "AVD-AWS-0132", Resource: "aws_s3_bucket.*"}}
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "tfsec-accepted",
+			filename: "../../test/tfsec-report.json",
+			setup: func(config *Config) {
+				config.Iac.SeverityLimit.High = configLimit{Enabled: true, Limit: 0}
+				config.Iac.CheckIDRiskAcceptance.Enabled = true
+				config.Iac.CheckIDRiskAcceptance.CheckIDs = []configCheckID{{ID: "AVD-AWS-0086"}, {ID: "AVD-AWS-0132"}}
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := NewDefaultConfig()
+			testCase.setup(config)
+			_, err := validateIacRules(DefaultValidators().Iac, config, newReport(t, testCase.filename))
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("want: %v got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func Test_validateTrufflehogRules(t *testing.T) {
+	newReport := func(t *testing.T) *artifacts.TrufflehogReportMin {
+		f, err := os.Open("../../test/trufflehog-report.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.TrufflehogReportMin{}
+		if err := artifacts.DecodeTrufflehog(f, report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	testTable := []struct {
+		name    string
+		setup   func(config *Config)
+		wantErr error
+	}{
+		{name: "default", setup: func(config *Config) {}, wantErr: nil},
+		{
+			name: "verified-only",
+			setup: func(config *Config) {
+				config.Trufflehog.VerifiedLimit = configLimit{Enabled: true, Limit: 0}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "verified-within-limit",
+			setup: func(config *Config) {
+				config.Trufflehog.VerifiedLimit = configLimit{Enabled: true, Limit: 1}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unverified-detector-limit",
+			setup: func(config *Config) {
+				config.Trufflehog.UnverifiedLimit.Enabled = true
+				config.Trufflehog.UnverifiedLimit.Detectors = []configDetector{{Name: "slack", Limit: 1}}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "unverified-detector-within-limit",
+			setup: func(config *Config) {
+				config.Trufflehog.UnverifiedLimit.Enabled = true
+				config.Trufflehog.UnverifiedLimit.Detectors = []configDetector{{Name: "Slack", Limit: 2}, {Name: "Github", Limit: 1}}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unverified-ignores-verified",
+			setup: func(config *Config) {
+				config.Trufflehog.UnverifiedLimit.Enabled = true
+				config.Trufflehog.UnverifiedLimit.Detectors = []configDetector{{Name: "AWS", Limit: 0}}
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := NewDefaultConfig()
+			testCase.setup(config)
+			_, err := validateTrufflehogRules(DefaultValidators().Trufflehog, config, newReport(t))
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("want: %v got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func Test_validateGitleaksRules(t *testing.T) {
+	newReport := func(t *testing.T) *artifacts.GitLeaksReportMin {
+		f, err := os.Open("../../test/gitleaks-report.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.GitLeaksReportMin{}
+		if err := json.NewDecoder(f).Decode(report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	t.Run("allow-all-files", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Gitleaks.LimitEnabled = true
+		config.Gitleaks.Allowlist.Enabled = true
+		config.Gitleaks.Allowlist.Entries = []configGitleaksAllow{{File: "**", Reason: "test fixtures", ExpiresAt: "2999-01-01"}}
+		if _, err := validateGitleaksRules(DefaultValidators().Gitleaks, config, newReport(t)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("allow-expired", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Gitleaks.LimitEnabled = true
+		config.Gitleaks.Allowlist.Enabled = true
+		config.Gitleaks.Allowlist.Entries = []configGitleaksAllow{{File: "**", ExpiresAt: "2020-01-01"}}
+		_, err := validateGitleaksRules(DefaultValidators().Gitleaks, config, newReport(t))
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	t.Run("allow-invalid-expiration", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Gitleaks.LimitEnabled = true
+		config.Gitleaks.Allowlist.Enabled = true
+		config.Gitleaks.Allowlist.Entries = []configGitleaksAllow{{File: "**", ExpiresAt: "next year"}}
+		_, err := validateGitleaksRules(DefaultValidators().Gitleaks, config, newReport(t))
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+	})
+
+	testTable := []struct {
+		name      string
+		entry     configGitleaksAllow
+		wantCount int
+	}{
+		{name: "empty-entry", entry: configGitleaksAllow{Reason: "matches nothing"}, wantCount: 113},
+		{
+			name:      "fingerprint",
+			entry:     configGitleaksAllow{Fingerprint: "49453ae5701ce2142ec59212c4ceced1bcda1040:routes/login.ts:generic-api-key:66"},
+			wantCount: 112,
+		},
+		{name: "rule-id-and-file", entry: configGitleaksAllow{RuleID: "JWT", File: "test/server/*"}, wantCount: 96},
+		{name: "file-glob", entry: configGitleaksAllow{File: "test/**/*Spec.ts"}, wantCount: 113 - countGitleaks(t, newReport(t), "test/", "Spec.ts")},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Gitleaks.Allowlist.Enabled = true
+			config.Gitleaks.Allowlist.Entries = []configGitleaksAllow{testCase.entry}
+			findings := DefaultValidators().Gitleaks.Filter(*newReport(t), config)
+			if len(findings) != testCase.wantCount {
+				t.Fatalf("want: %d got: %d", testCase.wantCount, len(findings))
+			}
+		})
+	}
+}
+
+func countGitleaks(t *testing.T, report *artifacts.GitLeaksReportMin, prefix string, suffix string) int {
+	t.Helper()
+	n := 0
+	for _, finding := range *report {
+		if strings.HasPrefix(finding.File, prefix) && strings.HasSuffix(finding.File, suffix) {
+			n++
+		}
+	}
+	return n
+}
+
+func Test_globMatch(t *testing.T) {
+	testTable := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "**", name: "a/b/c.go", want: true},
+		{pattern: "*.go", name: "main.go", want: true},
+		{pattern: "*.go", name: "cmd/main.go", want: false},
+		{pattern: "**/*.go", name: "main.go", want: true},
+		{pattern: "**/*.go", name: "cmd/gatecheck/main.go", want: true},
+		{pattern: "test/**", name: "test/api/userApiSpec.ts", want: true},
+		{pattern: "test/**", name: "routes/login.ts", want: false},
+		{pattern: "test/**/fixtures/*", name: "test/a/b/fixtures/key.pem", want: true},
+		{pattern: "[", name: "[", want: false},
+	}
+
+	for _, testCase := range testTable {
+		if got := globMatch(testCase.pattern, testCase.name); got != testCase.want {
+			t.Errorf("pattern: %s name: %s want: %t got: %t", testCase.pattern, testCase.name, testCase.want, got)
+		}
+	}
+}
+
+func Test_semgrepRuleIDMatch(t *testing.T) {
+	testTable := []struct {
+		ruleID  string
+		checkID string
+		want    bool
+	}{
+		{ruleID: "python.lang.security.*", checkID: "python.lang.security.audit.eval-detected", want: true},
+		{ruleID: "Python.Lang.*", checkID: "python.lang.security.audit.eval-detected", want: true},
+		{ruleID: "python.lang.security.*", checkID: "javascript.lang.security.audit", want: false},
+		{ruleID: "p/owasp-top-ten/*", checkID: "p/owasp-top-ten/java.lang.security.audit", want: true},
+		{ruleID: "p/*", checkID: "p/owasp-top-ten/java.lang.security.audit", want: false},
+		{ruleID: "p/**", checkID: "p/owasp-top-ten/java.lang.security.audit", want: true},
+		{ruleID: "**/java.lang.*", checkID: "p/owasp-top-ten/java.lang.security.audit", want: true},
+	}
+
+	for _, testCase := range testTable {
+		_, got := semgrepRuleIDMatch([]configRuleID{{ID: testCase.ruleID}}, testCase.checkID)
+		if got != testCase.want {
+			t.Errorf("rule id: %s check id: %s want: %t got: %t", testCase.ruleID, testCase.checkID, testCase.want, got)
+		}
+	}
+}
+
+func Test_validateSemgrepRules(t *testing.T) {
+	newReport := func(t *testing.T) *artifacts.SemgrepReportMin {
+		f, err := os.Open("../../test/semgrep-sast-report.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		report := &artifacts.SemgrepReportMin{}
+		if err := json.NewDecoder(f).Decode(report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	testTable := []struct {
+		name    string
+		setup   func(config *Config)
+		wantErr error
+	}{
+		{name: "default", setup: func(config *Config) {}, wantErr: nil},
+		{
+			name: "rule-id-deny-glob",
+			setup: func(config *Config) {
+				config.Semgrep.RuleIDLimit.Enabled = true
+				config.Semgrep.RuleIDLimit.RuleIDs = []configRuleID{{ID: "javascript.sequelize.*"}}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "rule-id-deny-no-match",
+			setup: func(config *Config) {
+				config.Semgrep.RuleIDLimit.Enabled = true
+				config.Semgrep.RuleIDLimit.RuleIDs = []configRuleID{{ID: "python.lang.security.*"}}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "cwe-deny",
+			setup: func(config *Config) {
+				config.Semgrep.CWELimit.Enabled = true
+				config.Semgrep.CWELimit.IDs = []string{"cwe-89"}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "owasp-deny",
+			setup: func(config *Config) {
+				config.Semgrep.OwaspLimit.Enabled = true
+				config.Semgrep.OwaspLimit.IDs = []string{"A10:2021"}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "rule-id-accept",
+			setup: func(config *Config) {
+				config.Semgrep.SeverityLimit.Error = configLimit{Enabled: true, Limit: 0}
+				config.Semgrep.RuleIDRiskAcceptance.Enabled = true
+				config.Semgrep.RuleIDRiskAcceptance.RuleIDs = []configRuleID{{ID: "javascript.*"}, {ID: "generic.secrets.*"}}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "cwe-accept",
+			setup: func(config *Config) {
+				config.Semgrep.SeverityLimit.Error = configLimit{Enabled: true, Limit: 0}
+				config.Semgrep.CWERiskAcceptance.Enabled = true
+				config.Semgrep.CWERiskAcceptance.IDs = []string{"CWE-89", "CWE-798", "CWE-79"}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "owasp-accept-partial",
+			setup: func(config *Config) {
+				config.Semgrep.SeverityLimit.Error = configLimit{Enabled: true, Limit: 0}
+				config.Semgrep.OwaspRiskAcceptance.Enabled = true
+				config.Semgrep.OwaspRiskAcceptance.IDs = []string{"A03:2021"}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "owasp-accept",
+			setup: func(config *Config) {
+				config.Semgrep.SeverityLimit.Error = configLimit{Enabled: true, Limit: 1}
+				config.Semgrep.OwaspRiskAcceptance.Enabled = true
+				config.Semgrep.OwaspRiskAcceptance.IDs = []string{"A03:2021"}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error-limit",
+			setup: func(config *Config) {
+				config.Semgrep.ErrorPolicy.ErrorLimit = configLimit{Enabled: true, Limit: 31}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "error-limit-ignore-paths",
+			setup: func(config *Config) {
+				config.Semgrep.ErrorPolicy.ErrorLimit = configLimit{Enabled: true, Limit: 6}
+				config.Semgrep.ErrorPolicy.IgnorePaths = []string{"data/**"}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error-fail-type",
+			setup: func(config *Config) {
+				config.Semgrep.ErrorPolicy.FailTypes = []string{"timeout"}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "error-fail-type-ignored",
+			setup: func(config *Config) {
+				config.Semgrep.ErrorPolicy.FailTypes = []string{"Timeout"}
+				config.Semgrep.ErrorPolicy.IgnorePaths = []string{"frontend/**"}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "risk-limit-matrix-disabled",
+			setup: func(config *Config) {
+				config.Semgrep.RiskMatrix.RiskLimit.Critical = configLimit{Enabled: true, Limit: 0}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "risk-limit-critical",
+			setup: func(config *Config) {
+				config.Semgrep.RiskMatrix.Enabled = true
+				config.Semgrep.RiskMatrix.RiskLimit.Critical = configLimit{Enabled: true, Limit: 7}
+			},
+			wantErr: ErrValidationFailure,
+		},
+		{
+			name: "risk-limit-critical-within",
+			setup: func(config *Config) {
+				config.Semgrep.RiskMatrix.Enabled = true
+				config.Semgrep.RiskMatrix.RiskLimit.Critical = configLimit{Enabled: true, Limit: 8}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "risk-accept-high",
+			setup: func(config *Config) {
+				config.Semgrep.RiskMatrix.Enabled = true
+				config.Semgrep.RiskMatrix.RiskLimit.High = configLimit{Enabled: true, Limit: 0}
+				config.Semgrep.RiskMatrix.RiskAcceptance.Enabled = true
+				config.Semgrep.RiskMatrix.RiskAcceptance.High = true
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error-fail-level",
+			setup: func(config *Config) {
+				config.Semgrep.ErrorPolicy.FailLevels = []string{"error"}
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := NewDefaultConfig()
+			testCase.setup(config)
+			_, err := validateSemgrepRules(DefaultValidators().Semgrep, config, newReport(t))
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("want: %v got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func Test_semgrepRisk(t *testing.T) {
+	matrix := defaultSemgrepRiskMatrix()
+	testTable := []struct {
+		impact     string
+		likelihood string
+		confidence string
+		want       string
+	}{
+		{impact: "HIGH", likelihood: "HIGH", confidence: "HIGH", want: "critical"},
+		{impact: "HIGH", likelihood: "HIGH", confidence: "MEDIUM", want: "critical"},
+		{impact: "MEDIUM", likelihood: "HIGH", confidence: "MEDIUM", want: "high"},
+		{impact: "HIGH", likelihood: "HIGH", confidence: "LOW", want: "high"},
+		{impact: "MEDIUM", likelihood: "MEDIUM", confidence: "LOW", want: "medium"},
+		{impact: "HIGH", likelihood: "LOW", confidence: "LOW", want: "low"},
+		{impact: "low", likelihood: "low", confidence: "low", want: "low"},
+		{impact: "HIGH", likelihood: "HIGH", confidence: "", want: "unknown"},
+	}
+
+	for _, testCase := range testTable {
+		metadata := artifacts.SemgrepMetadata{Impact: testCase.impact, Likelihood: testCase.likelihood, Confidence: testCase.confidence}
+		if got := semgrepRisk(matrix, metadata); got != testCase.want {
+			t.Errorf("%+v want: %s got: %s", metadata, testCase.want, got)
+		}
+	}
+}
+
+func Test_fixAvailabilityRules(t *testing.T) {
+	newGrypeReport := func() *artifacts.GrypeReportMin {
+		return &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+			{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Critical", Fix: artifacts.GrypeFix{State: "fixed", Versions: []string{"1.2.3"}}}},
+			{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-2", Severity: "Critical", Fix: artifacts.GrypeFix{State: "not-fixed"}}},
+			{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-3", Severity: "Critical", Fix: artifacts.GrypeFix{State: "wont-fix"}}},
+		}}
+	}
+
+	testTable := []struct {
+		label       string
+		limit       uint
+		onlyFixable bool
+		states      []string
+		wantErr     error
+	}{
+		{label: "every-match-counted", limit: 1, wantErr: ErrValidationFailure},
+		{label: "only-fixable", limit: 1, onlyFixable: true, wantErr: nil},
+		{label: "only-fixable-exceeded", limit: 0, onlyFixable: true, wantErr: ErrValidationFailure},
+		{label: "accept-wont-fix", limit: 2, states: []string{"wont-fix"}, wantErr: nil},
+		{label: "accept-not-fixed-and-wont-fix", limit: 1, states: []string{"Not-Fixed", "wont-fix"}, wantErr: nil},
+		{label: "accept-wont-fix-exceeded", limit: 1, states: []string{"wont-fix"}, wantErr: ErrValidationFailure},
+	}
+
+	for _, testCase := range testTable {
+		t.Run("grype-"+testCase.label, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Grype.SeverityLimit.Critical.Enabled = true
+			config.Grype.SeverityLimit.Critical.Limit = testCase.limit
+			config.Grype.SeverityLimit.OnlyFixable = testCase.onlyFixable
+			config.Grype.FixStateRiskAcceptance.Enabled = len(testCase.states) > 0
+			config.Grype.FixStateRiskAcceptance.States = testCase.states
+
+			_, err := validateGrypeRules(DefaultValidators().Grype, config, newGrypeReport(), nil, nil)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("want: %v got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("fix-states", func(t *testing.T) {
+		cyclonedxFixed := artifacts.CyclonedxVulnerability{Affects: []artifacts.CyclondexAffectedPackage{
+			{Versions: []artifacts.CyclonedxAffectedVersion{{Version: "1.0.0", Status: "affected"}, {Version: "1.0.1", Status: "unaffected"}}},
+		}}
+		cyclonedxWontFix := artifacts.CyclonedxVulnerability{Analysis: artifacts.CyclonedxAnalysis{Response: []string{"will_not_fix"}}}
+		osvFixed := artifacts.OsvVulnerability{Affected: []artifacts.OsvAffected{
+			{Ranges: []artifacts.OsvRange{{Type: "SEMVER", Events: []artifacts.OsvRangeEvent{{Introduced: "0"}, {Fixed: "4.17.21"}}}}},
+		}}
+		osvNotFixed := artifacts.OsvVulnerability{Affected: []artifacts.OsvAffected{
+			{Ranges: []artifacts.OsvRange{{Type: "SEMVER", Events: []artifacts.OsvRangeEvent{{Introduced: "0"}}}}},
+		}}
+
+		testTable := []struct {
+			label string
+			got   string
+			want  string
+		}{
+			{label: "cyclonedx-unaffected-version", got: cyclonedxFixed.FixState(), want: artifacts.FixStateFixed},
+			{label: "cyclonedx-will-not-fix", got: cyclonedxWontFix.FixState(), want: artifacts.FixStateWontFix},
+			{label: "cyclonedx-no-data", got: (&artifacts.CyclonedxVulnerability{}).FixState(), want: artifacts.FixStateUnknown},
+			{label: "trivy-will-not-fix", got: (&artifacts.TrivyVulnerability{Status: "will_not_fix"}).FixState(), want: artifacts.FixStateWontFix},
+			{label: "trivy-fixed-version", got: (&artifacts.TrivyVulnerability{FixedVersion: "1.2.3"}).FixState(), want: artifacts.FixStateFixed},
+			{label: "osv-fixed-event", got: osvFixed.FixState(), want: artifacts.FixStateFixed},
+			{label: "osv-no-fixed-event", got: osvNotFixed.FixState(), want: artifacts.FixStateNotFixed},
+		}
+
+		for _, testCase := range testTable {
+			if testCase.got != testCase.want {
+				t.Errorf("%s want: %s got: %s", testCase.label, testCase.want, testCase.got)
+			}
+		}
+	})
+}
+
+func Test_preferredCVSS(t *testing.T) {
+	scores := []artifacts.CVSS{
+		{Version: "2.0", Score: 9.3, Source: "nvd"},
+		{Version: "3.1", Score: 7.5, Source: "nvd"},
+		{Version: "3.1", Score: 8.1, Source: "ghsa"},
+		{Version: "3.0", Score: 6.5, Source: "vendor"},
+	}
+
+	testTable := []struct {
+		label    string
+		versions []string
+		want     float64
+	}{
+		{label: "newest-first", versions: nil, want: 8.1},
+		{label: "prefer-3.1-highest-source", versions: []string{"3.1", "2.0"}, want: 8.1},
+		{label: "prefer-2.0", versions: []string{"2.0", "3.1"}, want: 9.3},
+		{label: "unlisted-fallback", versions: []string{"4.0", "3.0"}, want: 6.5},
+		{label: "only-unlisted", versions: []string{"4.0"}, want: 8.1},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.label, func(t *testing.T) {
+			got, ok := preferredCVSS(scores, testCase.versions)
+			if !ok || got.Score != testCase.want {
+				t.Fatalf("want: %v got: %v", testCase.want, got.Score)
+			}
+		})
+	}
+
+	if _, ok := preferredCVSS(nil, []string{"3.1"}); ok {
+		t.Fatal("want no score for an empty slice")
+	}
+}
+
+func Test_ruleGrypeCVSSLimit(t *testing.T) {
+	report := &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+		{
+			Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Medium"},
+			RelatedVulnerabilities: []artifacts.GrypeRelatedVulnerability{{ID: "CVE-1", Namespace: "nvd:cpe", CVSS: []artifacts.GrypeCVSS{
+				{Version: "2.0", Vector: "AV:N/AC:L/Au:N/C:P/I:P/A:P"},
+				{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"},
+			}}},
+		},
+	}}
+	report.Matches[0].RelatedVulnerabilities[0].CVSS[0].Metrics.BaseScore = 7.5
+	report.Matches[0].RelatedVulnerabilities[0].CVSS[1].Metrics.BaseScore = 9.8
+
+	config := NewDefaultConfig()
+	config.Grype.CVSSLimit.Enabled = true
+	config.Grype.CVSSLimit.Score = 9.0
+
+	if ruleCVSSLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}) == nil {
+		t.Fatal("want the related vulnerability v3.1 score to fail the limit")
+	}
+
+	config.Grype.CVSSLimit.Versions = []string{"2.0"}
+	if ruleCVSSLimit(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype}) != nil {
+		t.Fatal("want the preferred v2.0 score to pass the limit")
+	}
+}
+
+func Test_epssPercentile(t *testing.T) {
+	cve := epss.CVE{EPSS: "0.02", Percentile: "0.96"}
+
+	limitTable := []struct {
+		name  string
+		limit configEPSSLimit
+		want  bool
+	}{
+		{name: "score-only-under", limit: configEPSSLimit{Score: 0.1}, want: false},
+		{name: "percentile-only-over", limit: configEPSSLimit{Percentile: 0.95}, want: true},
+		{name: "percentile-only-under", limit: configEPSSLimit{Percentile: 0.97}, want: false},
+		{name: "both-either-over", limit: configEPSSLimit{Score: 0.1, Percentile: 0.95}, want: true},
+	}
+	for _, testCase := range limitTable {
+		t.Run("limit-"+testCase.name, func(t *testing.T) {
+			if got := epssOverLimit(testCase.limit, cve); got != testCase.want {
+				t.Fatalf("want: %t got: %t", testCase.want, got)
+			}
+		})
+	}
+
+	acceptanceTable := []struct {
+		name       string
+		acceptance configEPSSRiskAcceptance
+		want       bool
+	}{
+		{name: "score-only-under", acceptance: configEPSSRiskAcceptance{Score: 0.1}, want: true},
+		{name: "percentile-only-over", acceptance: configEPSSRiskAcceptance{Percentile: 0.95}, want: false},
+		{name: "percentile-only-under", acceptance: configEPSSRiskAcceptance{Percentile: 0.97}, want: true},
+		{name: "both-must-be-under", acceptance: configEPSSRiskAcceptance{Score: 0.1, Percentile: 0.95}, want: false},
+	}
+	for _, testCase := range acceptanceTable {
+		t.Run("acceptance-"+testCase.name, func(t *testing.T) {
+			if got := epssRiskAccepted(testCase.acceptance, cve); got != testCase.want {
+				t.Fatalf("want: %t got: %t", testCase.want, got)
+			}
+		})
+	}
+
+	t.Run("grype-rules", func(t *testing.T) {
+		report := &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+			{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Low"}},
+			{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-2", Severity: "High"}},
+		}}
+		data := &epss.Data{CVEs: map[string]epss.CVE{
+			"CVE-1": {EPSS: "0.001", Percentile: "0.20"},
+			"CVE-2": {EPSS: "0.02", Percentile: "0.96"},
+		}}
+		config := NewDefaultConfig()
+		config.Grype.EPSSRiskAcceptance.Enabled = true
+		config.Grype.EPSSRiskAcceptance.Percentile = 0.5
+		config.Grype.EPSSLimit.Enabled = true
+		config.Grype.EPSSLimit.Percentile = 0.95
+
+		findings := allowedFindings(report.NormalizedFindings(), CVERuleConfig{Artifact: "grype", Config: config.Grype, EPSSData: data}, ruleEPSSAllow)
+		if len(findings) != 1 || findings[0].ID != "CVE-2" {
+			t.Fatalf("want only CVE-2 after risk acceptance got: %+v", findings)
+		}
+		if ruleEPSSLimit(findings, CVERuleConfig{Artifact: "grype", Config: config.Grype, EPSSData: data}) == nil {
+			t.Fatal("want the top 5 percent CVE to fail the percentile limit")
+		}
+	})
+}
+
+func Test_cyclonedxRatingPreference(t *testing.T) {
+	vulnerability := artifacts.CyclonedxVulnerability{ID: "CVE-1", Ratings: []artifacts.CyclonedxRating{
+		{Source: artifacts.CyclonedxSource{Name: "ubuntu"}, Severity: "low"},
+		{Source: artifacts.CyclonedxSource{Name: "nvd"}, Severity: "critical"},
+		{Source: artifacts.CyclonedxSource{Name: "ghsa"}, Severity: "medium"},
+		{Source: artifacts.CyclonedxSource{Name: "vendor"}, Severity: "unknown"},
+	}}
+
+	testTable := []struct {
+		name       string
+		preference artifacts.CyclonedxRatingPreference
+		want       string
+	}{
+		{name: "default-highest", preference: artifacts.CyclonedxRatingPreference{}, want: "critical"},
+		{name: "preferred-source", preference: artifacts.CyclonedxRatingPreference{Sources: []string{"GHSA", "nvd"}}, want: "medium"},
+		{name: "unrated-source-skipped", preference: artifacts.CyclonedxRatingPreference{Sources: []string{"vendor", "ubuntu"}}, want: "low"},
+		{name: "fallback-first", preference: artifacts.CyclonedxRatingPreference{Sources: []string{"redhat"}, Fallback: "first"}, want: "low"},
+		{name: "fallback-source", preference: artifacts.CyclonedxRatingPreference{Fallback: "ghsa"}, want: "medium"},
+		{name: "fallback-missing-source", preference: artifacts.CyclonedxRatingPreference{Fallback: "redhat"}, want: "critical"},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := vulnerability.Severity(testCase.preference); got != testCase.want {
+				t.Fatalf("want: %s got: %s", testCase.want, got)
+			}
+		})
+	}
+
+	t.Run("no-ratings", func(t *testing.T) {
+		unrated := artifacts.CyclonedxVulnerability{ID: "CVE-2"}
+		if got := unrated.HighestSeverity(); got != artifacts.CyclonedxSeverityUnknown {
+			t.Fatalf("want: %s got: %s", artifacts.CyclonedxSeverityUnknown, got)
+		}
+	})
+
+	t.Run("severity-limit", func(t *testing.T) {
+		report := &artifacts.CyclonedxReportMin{Vulnerabilities: []artifacts.CyclonedxVulnerability{vulnerability, {ID: "CVE-2"}}}
+		config := NewDefaultConfig()
+		config.Cyclonedx.SeverityLimit.Critical.Enabled = true
+		config.Cyclonedx.SeverityLimit.Critical.Limit = 0
+		if ruleCVESeverityLimit(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}) == nil {
+			t.Fatal("want the highest nvd rating to fail the critical limit")
+		}
+
+		config.Cyclonedx.RatingPreference.Sources = []string{"ghsa"}
+		if ruleCVESeverityLimit(report.NormalizedFindings(config.Cyclonedx.RatingPreference.cyclonedx()), CVERuleConfig{Artifact: "cyclonedx", Config: config.Cyclonedx.ReportWithCVEs}) != nil {
+			t.Fatal("want the preferred ghsa rating to pass the critical limit")
+		}
+	})
+}
+
+func Test_validateCVERules_aliases(t *testing.T) {
+	report := &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{{
+		Vulnerability:          artifacts.GrypeVulnerability{ID: "GHSA-1", Severity: "High"},
+		RelatedVulnerabilities: []artifacts.GrypeRelatedVulnerability{{ID: "CVE-1", Namespace: "nvd:cpe"}},
+	}}}
+
+	findings := report.NormalizedFindings()
+	if len(findings) != 1 || findings[0].CVE() != "CVE-1" || !findings[0].HasID("ghsa-1") {
+		t.Fatalf("want GHSA-1 with the CVE-1 alias got: %+v", findings)
+	}
+
+	config := NewDefaultConfig()
+	config.Grype.CVELimit.Enabled = true
+	config.Grype.CVELimit.CVEs = []configCVE{{ID: "CVE-1"}}
+	if _, err := validateGrypeRules(DefaultValidators().Grype, config, report, nil, nil); !errors.Is(err, ErrValidationFailure) {
+		t.Fatalf("want: %v for a denied alias got: %v", ErrValidationFailure, err)
+	}
+
+	config = NewDefaultConfig()
+	config.Grype.EPSSLimit.Enabled = true
+	config.Grype.EPSSLimit.Score = 0.5
+	data := &epss.Data{CVEs: map[string]epss.CVE{"CVE-1": {EPSS: "0.9", Percentile: "0.99"}}}
+	if _, err := validateGrypeRules(DefaultValidators().Grype, config, report, nil, data); !errors.Is(err, ErrValidationFailure) {
+		t.Fatalf("want: %v for the alias epss score got: %v", ErrValidationFailure, err)
+	}
+}
+
+func Test_validateGrypeRules_failedRules(t *testing.T) {
+	report := &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+		{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Critical"}},
+		{Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-2", Severity: "Critical"}},
+	}}
+
+	t.Run("names-offending-ids", func(t *testing.T) {
+		config := NewDefaultConfig()
+		config.Grype.SeverityLimit.Critical.Enabled = true
+		config.Grype.SeverityLimit.Critical.Limit = 0
+
+		_, err := validateGrypeRules(DefaultValidators().Grype, config, report, nil, nil)
+		if !errors.Is(err, ErrValidationFailure) || !errors.Is(err, validate.ErrFailedRule) {
+			t.Fatalf("want: %v and %v got: %v", ErrValidationFailure, validate.ErrFailedRule, err)
+		}
+		for _, want := range []string{"grype.severityLimit.critical: CVE-1", "grype.severityLimit.critical: CVE-2"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Fatalf("want: %q in %q", want, err.Error())
+			}
+		}
+	})
+
+	t.Run("same-id-in-two-packages", func(t *testing.T) {
+		report := &artifacts.GrypeReportMin{Matches: []artifacts.GrypeMatch{
+			{
+				Artifact:      artifacts.GrypeArtifact{Name: "libfixed", Version: "1.0.0"},
+				Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Critical", Fix: artifacts.GrypeFix{State: "fixed"}},
+			},
+			{
+				Artifact:      artifacts.GrypeArtifact{Name: "libnotfixed", Version: "1.0.0"},
+				Vulnerability: artifacts.GrypeVulnerability{ID: "CVE-1", Severity: "Critical", Fix: artifacts.GrypeFix{State: "not-fixed"}},
+			},
+		}}
+		config := NewDefaultConfig()
+		config.Grype.SeverityLimit.OnlyFixable = true
+		config.Grype.SeverityLimit.Critical.Enabled = true
+		config.Grype.SeverityLimit.Critical.Limit = 0
+
+		outcomes, err := validateGrypeRules(DefaultValidators().Grype, config, report, nil, nil)
+		if !errors.Is(err, ErrValidationFailure) {
+			t.Fatalf("want: %v got: %v", ErrValidationFailure, err)
+		}
+		if !slices.Equal(outcomes[0].FailedRules, []string{"grype.severityLimit.critical"}) {
+			t.Fatalf("want the fixable package to fail got: %v", outcomes[0].FailedRules)
+		}
+		if len(outcomes[1].FailedRules) != 0 {
+			t.Fatalf("want the package without a fix to pass got: %v", outcomes[1].FailedRules)
+		}
+	})
+
+	t.Run("custom-rule-after-risk-acceptance", func(t *testing.T) {
+		seen := []string{}
+		validators := DefaultValidators()
+		validators.Grype = validators.Grype.WithValidationRules(func(findings []artifacts.Finding, config CVERuleConfig) error {
+			return validate.DenyFunc(findings, func(finding artifacts.Finding) error {
+				seen = append(seen, finding.ID)
+				return validate.NewFailedRuleError(config.Artifact+".customLimit", finding.Key())
+			})
+		})
+
+		config := NewDefaultConfig()
+		config.Grype.CVERiskAcceptance.Enabled = true
+		config.Grype.CVERiskAcceptance.CVEs = []configCVE{{ID: "CVE-1"}}
+
+		content, err := json.Marshal(report)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = Validate(config, bytes.NewReader(content), "grype-report.json", WithInputType(ReportTypeGrype), WithValidators(validators))
+		if !errors.Is(err, validate.ErrFailedRule) || !strings.Contains(err.Error(), "grype.customLimit: CVE-2") {
+			t.Fatalf("want the custom rule to fail got: %v", err)
+		}
+		if len(seen) != 1 || seen[0] != "CVE-2" {
+			t.Fatalf("want only the unaccepted CVE-2 got: %v", seen)
+		}
+
+		if err := Validate(config, bytes.NewReader(content), "grype-report.json", WithInputType(ReportTypeGrype)); err != nil {
+			t.Fatalf("want the default validators unchanged got: %v", err)
+		}
+	})
+}
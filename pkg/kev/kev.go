@@ -7,6 +7,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -34,6 +35,13 @@ type Vulnerability struct {
 	RequiredAction    string `json:"requiredAction"`
 	DueDate           string `json:"dueDate"`
 	Notes             string `json:"notes"`
+	// KnownRansomwareCampaignUse "Known" or "Unknown"
+	KnownRansomwareCampaignUse string `json:"knownRansomwareCampaignUse"`
+}
+
+// RansomwareKnown the vulnerability is known to be used in ransomware campaigns
+func (v Vulnerability) RansomwareKnown() bool {
+	return strings.EqualFold(v.KnownRansomwareCampaignUse, "Known")
 }
 
 func NewCatalog() *Catalog {
@@ -17,9 +17,43 @@ var ErrFailedRule = errors.New("Failed Rule")
 // ErrConfig return this error if the configuration file is invalid
 var ErrConfig = errors.New("cannot validate, invalid configuration")
 
+// UnnamedAllowRule the name reported for allow rules added without a name
+const UnnamedAllowRule = "allowRule"
+
+// FailedRuleError the rule that failed and the ID of the object that failed it, wraps ErrFailedRule
+type FailedRuleError struct {
+	Rule string
+	ID   string
+}
+
+func (e *FailedRuleError) Error() string {
+	return fmt.Sprintf("%v: %v: %s", ErrFailedRule, e.Rule, e.ID)
+}
+
+func (e *FailedRuleError) Unwrap() error {
+	return ErrFailedRule
+}
+
 // NewFailedRuleError convenience function for error wrapping
 func NewFailedRuleError(rule string, id string) error {
-	return fmt.Errorf("%w: %v: %s", ErrFailedRule, rule, id)
+	return &FailedRuleError{Rule: rule, ID: id}
+}
+
+// failedRules every FailedRuleError in the error tree
+func failedRules(err error) []*FailedRuleError {
+	switch err := err.(type) {
+	case nil:
+		return nil
+	case *FailedRuleError:
+		return []*FailedRuleError{err}
+	case interface{ Unwrap() []error }:
+		failed := []*FailedRuleError{}
+		for _, wrapped := range err.Unwrap() {
+			failed = append(failed, failedRules(wrapped)...)
+		}
+		return failed
+	}
+	return failedRules(errors.Unwrap(err))
 }
 
 // DenyFunc generic execution of a check function over a slice of objects
@@ -34,18 +68,32 @@ func DenyFunc[S ~[]E, E any](target S, check func(E) error) error {
 // Validator generic validation runner
 type Validator[ObjectT any, ConfigT any] struct {
 	validationRules []func([]ObjectT, ConfigT) error
-	allowListRules  []func(ObjectT, ConfigT) bool
+	allowListRules  []allowRule[ObjectT, ConfigT]
+}
+
+type allowRule[ObjectT any, ConfigT any] struct {
+	name  string
+	allow func(ObjectT, ConfigT) bool
 }
 
 // WithValidationRules define the fail validation rules, all must pass
 func (v Validator[ObjectT, ConfigT]) WithValidationRules(rules ...func([]ObjectT, ConfigT) error) Validator[ObjectT, ConfigT] {
-	v.validationRules = append(v.validationRules, rules...)
+	// clip so validators derived from the same value never share appended rules
+	v.validationRules = append(slices.Clip(v.validationRules), rules...)
 	return v
 }
 
 // WithAllowRules define the allow rules which will skip validation
 func (v Validator[ObjectT, ConfigT]) WithAllowRules(rules ...func(ObjectT, ConfigT) bool) Validator[ObjectT, ConfigT] {
-	v.allowListRules = append(v.allowListRules, rules...)
+	for _, rule := range rules {
+		v = v.WithNamedAllowRule(UnnamedAllowRule, rule)
+	}
+	return v
+}
+
+// WithNamedAllowRule define an allow rule with the name Explain reports for the objects it allows
+func (v Validator[ObjectT, ConfigT]) WithNamedAllowRule(name string, rule func(ObjectT, ConfigT) bool) Validator[ObjectT, ConfigT] {
+	v.allowListRules = append(slices.Clip(v.allowListRules), allowRule[ObjectT, ConfigT]{name: name, allow: rule})
 	return v
 }
 
@@ -54,25 +102,43 @@ func NewValidator[ObjectT any, ConfigT any]() Validator[ObjectT, ConfigT] {
 	return Validator[ObjectT, ConfigT]{}
 }
 
+// Filter the objects that no allow rule matches, the objects slice is not modified
+func (v Validator[ObjectT, ConfigT]) Filter(objects []ObjectT, config ConfigT) []ObjectT {
+	return slices.DeleteFunc(slices.Clone(objects), func(obj ObjectT) bool {
+		_, allowed := v.allowedBy(obj, config)
+		return allowed
+	})
+}
+
+// allowedBy the name of the first allow rule that matches the object
+func (v Validator[ObjectT, ConfigT]) allowedBy(obj ObjectT, config ConfigT) (string, bool) {
+	for _, rule := range v.allowListRules {
+		if rule.allow(obj, config) {
+			return rule.name, true
+		}
+	}
+	return "", false
+}
+
 // Validate run validation rules on a slice of objects
 func (v Validator[ObjectT, ConfigT]) Validate(objects []ObjectT, config ConfigT) error {
-	var errs error
-	filteredObjects := slices.DeleteFunc(objects, func(obj ObjectT) bool {
-		for _, allow := range v.allowListRules {
-			if allow(obj, config) {
-				return true
-			}
-		}
-		return false
-	})
+	_, err := v.run(objects, config)
+	return err
+}
 
-	oCount := len(objects) - len(filteredObjects)
-	filteredCount := len(filteredObjects)
-	slog.Debug("validation", "object_count", oCount, "allowed_count", filteredCount)
+// run the validation rules on the filtered objects, the filtered objects are returned for subsequent validators
+func (v Validator[ObjectT, ConfigT]) run(objects []ObjectT, config ConfigT) ([]ObjectT, error) {
+	filteredObjects := v.Filter(objects, config)
+	return filteredObjects, v.validate(len(objects), filteredObjects, config)
+}
+
+// validate the validation rules on objects that are already filtered, objectCount is the count before filtering
+func (v Validator[ObjectT, ConfigT]) validate(objectCount int, filteredObjects []ObjectT, config ConfigT) error {
+	var errs error
+	slog.Debug("validation", "object_count", objectCount, "allowed_count", objectCount-len(filteredObjects))
 	for _, validate := range v.validationRules {
 		errs = errors.Join(errs, validate(filteredObjects, config))
 	}
-
 	return errs
 }
 
@@ -85,6 +151,134 @@ func (v Validator[ObjectT, ConfigT]) ReadConfigAndValidate(objects []ObjectT, co
 	return v.Validate(objects, config)
 }
 
+// Pipeline validators that run in order
+//
+// Objects allowed by a validator are removed before the next validator runs,
+// validation stops at the first validator with a failed rule
+type Pipeline[ObjectT any, ConfigT any] struct {
+	validators []Validator[ObjectT, ConfigT]
+}
+
+// NewPipeline the validators run in the order given
+func NewPipeline[ObjectT any, ConfigT any](validators ...Validator[ObjectT, ConfigT]) Pipeline[ObjectT, ConfigT] {
+	return Pipeline[ObjectT, ConfigT]{validators: slices.Clone(validators)}
+}
+
+// WithValidator add a validator that runs after the existing validators
+func (p Pipeline[ObjectT, ConfigT]) WithValidator(validator Validator[ObjectT, ConfigT]) Pipeline[ObjectT, ConfigT] {
+	p.validators = append(slices.Clip(p.validators), validator)
+	return p
+}
+
+// WithValidationRules add validation rules to the last validator
+func (p Pipeline[ObjectT, ConfigT]) WithValidationRules(rules ...func([]ObjectT, ConfigT) error) Pipeline[ObjectT, ConfigT] {
+	return p.withLast(func(v Validator[ObjectT, ConfigT]) Validator[ObjectT, ConfigT] {
+		return v.WithValidationRules(rules...)
+	})
+}
+
+// WithAllowRules add allow rules to the last validator
+func (p Pipeline[ObjectT, ConfigT]) WithAllowRules(rules ...func(ObjectT, ConfigT) bool) Pipeline[ObjectT, ConfigT] {
+	return p.withLast(func(v Validator[ObjectT, ConfigT]) Validator[ObjectT, ConfigT] {
+		return v.WithAllowRules(rules...)
+	})
+}
+
+// WithNamedAllowRule add a named allow rule to the last validator
+func (p Pipeline[ObjectT, ConfigT]) WithNamedAllowRule(name string, rule func(ObjectT, ConfigT) bool) Pipeline[ObjectT, ConfigT] {
+	return p.withLast(func(v Validator[ObjectT, ConfigT]) Validator[ObjectT, ConfigT] {
+		return v.WithNamedAllowRule(name, rule)
+	})
+}
+
+func (p Pipeline[ObjectT, ConfigT]) withLast(update func(Validator[ObjectT, ConfigT]) Validator[ObjectT, ConfigT]) Pipeline[ObjectT, ConfigT] {
+	if len(p.validators) == 0 {
+		return p.WithValidator(update(NewValidator[ObjectT, ConfigT]()))
+	}
+	p.validators = slices.Clone(p.validators)
+	last := len(p.validators) - 1
+	p.validators[last] = update(p.validators[last])
+	return p
+}
+
+// Filter the objects left after the allow rules of every validator, the objects slice is not modified
+func (p Pipeline[ObjectT, ConfigT]) Filter(objects []ObjectT, config ConfigT) []ObjectT {
+	for _, validator := range p.validators {
+		objects = validator.Filter(objects, config)
+	}
+	return objects
+}
+
+// Validate run each validator on the objects left by the previous validators
+func (p Pipeline[ObjectT, ConfigT]) Validate(objects []ObjectT, config ConfigT) error {
+	for _, validator := range p.validators {
+		var err error
+		objects, err = validator.run(objects, config)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Outcome the failed rules and the allow rule of a single object, see Pipeline.Explain
+type Outcome struct {
+	// FailedRules the name of every rule that failed for the object, in the order the rules ran
+	FailedRules []string
+	// AllowedBy the name of the allow rule that removed the object, "" if no allow rule matched
+	AllowedBy string
+}
+
+// Explain run the pipeline like Validate and return the outcome of each object by index with the same error
+//
+// A failed rule is matched to an object when the ID given to NewFailedRuleError is id(object),
+// only objects that reached the failed validator are matched.
+// id must be unique for each object, every object with the same ID is matched to the failed rule.
+// Allow rules of the validators after a failed validator still run so every allowed object is named
+func (p Pipeline[ObjectT, ConfigT]) Explain(objects []ObjectT, config ConfigT, id func(ObjectT) string) ([]Outcome, error) {
+	outcomes := make([]Outcome, len(objects))
+	remaining := make([]int, len(objects))
+	for i := range remaining {
+		remaining[i] = i
+	}
+
+	var failed error
+	for _, validator := range p.validators {
+		kept := make([]int, 0, len(remaining))
+		for _, i := range remaining {
+			if name, allowed := validator.allowedBy(objects[i], config); allowed {
+				outcomes[i].AllowedBy = name
+				continue
+			}
+			kept = append(kept, i)
+		}
+		objectCount := len(remaining)
+		remaining = kept
+
+		if failed != nil {
+			continue
+		}
+
+		filteredObjects := make([]ObjectT, 0, len(remaining))
+		for _, i := range remaining {
+			filteredObjects = append(filteredObjects, objects[i])
+		}
+		failed = validator.validate(objectCount, filteredObjects, config)
+
+		rulesByID := map[string][]string{}
+		for _, failedRule := range failedRules(failed) {
+			if !slices.Contains(rulesByID[failedRule.ID], failedRule.Rule) {
+				rulesByID[failedRule.ID] = append(rulesByID[failedRule.ID], failedRule.Rule)
+			}
+		}
+		for _, i := range remaining {
+			outcomes[i].FailedRules = append(outcomes[i].FailedRules, rulesByID[id(objects[i])]...)
+		}
+	}
+
+	return outcomes, failed
+}
+
 // ConfigByField get the config field name after decoding
 func ConfigByField[T any](configReader io.Reader, fieldname string) (T, error) {
 	configMap := make(map[string]T)
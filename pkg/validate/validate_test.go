@@ -84,3 +84,104 @@ func TestValidator(t *testing.T) {
 		}
 	})
 }
+
+func TestPipeline(t *testing.T) {
+	sample := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	config := mockConfig{Enabled: true}
+
+	allowOdd := func(i int, _ mockConfig) bool { return i%2 != 0 }
+	allowOverFour := func(i int, _ mockConfig) bool { return i > 4 }
+
+	t.Run("allowed-objects-removed-for-later-validators", func(t *testing.T) {
+		pipeline := NewPipeline(NewValidator[int, mockConfig]().WithAllowRules(allowOdd)).
+			WithValidator(NewValidator[int, mockConfig]().WithAllowRules(allowOverFour).WithValidationRules(underFive))
+		if err := pipeline.Validate(sample, config); err != nil {
+			t.Fatalf("want: nil got: %v", err)
+		}
+		if len(sample) != 10 || sample[0] != 1 {
+			t.Fatalf("want objects unmodified got: %v", sample)
+		}
+	})
+	t.Run("stops-at-first-failure", func(t *testing.T) {
+		laterRan := false
+		pipeline := NewPipeline(
+			NewValidator[int, mockConfig]().WithValidationRules(isEven),
+			NewValidator[int, mockConfig]().WithValidationRules(func(_ []int, _ mockConfig) error {
+				laterRan = true
+				return nil
+			}),
+		)
+		err := pipeline.Validate(sample, config)
+		if !errors.Is(err, ErrFailedRule) {
+			t.Fatalf("want: %v got: %v", ErrFailedRule, err)
+		}
+		if laterRan {
+			t.Fatal("want later validators skipped after a failure")
+		}
+	})
+	t.Run("rules-added-to-last-validator", func(t *testing.T) {
+		base := NewPipeline[int, mockConfig]().WithAllowRules(allowOdd)
+		extended := base.WithValidationRules(isEven)
+		if err := extended.Validate(sample, config); err != nil {
+			t.Fatalf("want: nil got: %v", err)
+		}
+		if err := base.WithValidationRules(underFive).Validate(sample, config); !errors.Is(err, ErrFailedRule) {
+			t.Fatalf("want: %v got: %v", ErrFailedRule, err)
+		}
+	})
+}
+
+func TestPipeline_Explain(t *testing.T) {
+	sample := []int{1, 2, 3, 4, 5, 6}
+	config := mockConfig{Enabled: true}
+	id := func(i int) string { return fmt.Sprint(i) }
+
+	t.Run("failed-rules-and-allow-rules-by-object", func(t *testing.T) {
+		pipeline := NewPipeline(
+			NewValidator[int, mockConfig]().WithNamedAllowRule("one", func(i int, _ mockConfig) bool { return i == 1 }),
+			NewValidator[int, mockConfig]().WithAllowRules(func(i int, _ mockConfig) bool { return i == 2 }).WithValidationRules(underFive),
+		)
+		outcomes, err := pipeline.Explain(sample, config, id)
+		if !errors.Is(err, ErrFailedRule) || err.Error() != pipeline.Validate(sample, config).Error() {
+			t.Fatalf("want the Validate error got: %v", err)
+		}
+		if outcomes[0].AllowedBy != "one" || outcomes[1].AllowedBy != UnnamedAllowRule {
+			t.Fatalf("want allowed by one and %s got: %+v", UnnamedAllowRule, outcomes[:2])
+		}
+		for i, want := range [][]string{nil, nil, nil, nil, {"must be less than 5"}, {"must be less than 5"}} {
+			if strings.Join(outcomes[i].FailedRules, ",") != strings.Join(want, ",") {
+				t.Fatalf("object %d want: %v got: %v", sample[i], want, outcomes[i].FailedRules)
+			}
+		}
+	})
+	t.Run("allow-rules-after-a-failure", func(t *testing.T) {
+		pipeline := NewPipeline(
+			NewValidator[int, mockConfig]().WithValidationRules(underFive),
+			NewValidator[int, mockConfig]().WithNamedAllowRule("six", func(i int, _ mockConfig) bool { return i == 6 }).WithValidationRules(isEven),
+		)
+		outcomes, err := pipeline.Explain(sample, config, id)
+		if !errors.Is(err, ErrFailedRule) {
+			t.Fatalf("want: %v got: %v", ErrFailedRule, err)
+		}
+		if outcomes[5].AllowedBy != "six" || len(outcomes[5].FailedRules) != 1 {
+			t.Fatalf("want six failed before it was allowed got: %+v", outcomes[5])
+		}
+		if len(outcomes[2].FailedRules) != 0 {
+			t.Fatalf("want validators after the failure skipped got: %v", outcomes[2].FailedRules)
+		}
+	})
+}
+
+func TestFailedRuleError(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", errors.Join(NewFailedRuleError("rule-a", "1"), errors.Join(NewFailedRuleError("rule-b", "2"))))
+	if !errors.Is(err, ErrFailedRule) {
+		t.Fatalf("want: %v got: %v", ErrFailedRule, err)
+	}
+	failed := failedRules(err)
+	if len(failed) != 2 || failed[0].Rule != "rule-a" || failed[1].ID != "2" {
+		t.Fatalf("want both failed rules got: %v", failed)
+	}
+	if got := NewFailedRuleError("rule-a", "1").Error(); got != "Failed Rule: rule-a: 1" {
+		t.Fatalf("want: Failed Rule: rule-a: 1 got: %s", got)
+	}
+}